@@ -27,6 +27,14 @@ var (
 	// authorized or the user does not have access to the
 	// resource.
 	ErrNotAuthorized = errors.New("Not Authorized")
+
+	// ErrConflict indicates the request conflicts with the
+	// current state of the resource.
+	ErrConflict = errors.New("Conflict")
+
+	// ErrValidation indicates the request was rejected
+	// because one or more fields are invalid.
+	ErrValidation = errors.New("Validation Failed")
 )
 
 type (
@@ -90,10 +98,14 @@ type (
 		Driver        Driver
 		Linker        Linker
 		Contents      ContentService
+		Deployments   DeploymentService
 		Git           GitService
 		Organizations OrganizationService
 		Issues        IssueService
+		Labels        LabelService
+		Milestones    MilestoneService
 		PullRequests  PullRequestService
+		Releases      ReleaseService
 		Repositories  RepositoryService
 		Reviews       ReviewService
 		Users         UserService
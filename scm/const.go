@@ -39,6 +39,9 @@ const (
 	// pull requests
 	ActionSync
 	ActionMerge
+	// pull request reviews
+	ActionApprove
+	ActionUnapprove
 )
 
 // String returns the string representation of Action.
@@ -64,6 +67,10 @@ func (a Action) String() (s string) {
 		return "synchronized"
 	case ActionMerge:
 		return "merged"
+	case ActionApprove:
+		return "approved"
+	case ActionUnapprove:
+		return "unapproved"
 	default:
 		return
 	}
@@ -101,6 +108,248 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionSync
 	case "merged":
 		*a = ActionMerge
+	case "approved":
+		*a = ActionApprove
+	case "unapproved":
+		*a = ActionUnapprove
+	}
+	return nil
+}
+
+// ReviewState represents the state of a pull request review.
+type ReviewState int
+
+// ReviewState values.
+const (
+	ReviewStateUnknown ReviewState = iota
+	ReviewStateApproved
+	ReviewStateChangesRequested
+	ReviewStateCommented
+	ReviewStateDismissed
+)
+
+// String returns the string representation of ReviewState.
+func (s ReviewState) String() string {
+	switch s {
+	case ReviewStateApproved:
+		return "approved"
+	case ReviewStateChangesRequested:
+		return "changes_requested"
+	case ReviewStateCommented:
+		return "commented"
+	case ReviewStateDismissed:
+		return "dismissed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded ReviewState.
+func (s ReviewState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded ReviewState.
+func (s *ReviewState) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case ReviewStateApproved.String():
+		*s = ReviewStateApproved
+	case ReviewStateChangesRequested.String():
+		*s = ReviewStateChangesRequested
+	case ReviewStateCommented.String():
+		*s = ReviewStateCommented
+	case ReviewStateDismissed.String():
+		*s = ReviewStateDismissed
+	default:
+		*s = ReviewStateUnknown
+	}
+	return nil
+}
+
+// ReviewSide identifies the side of the diff a review
+// comment is anchored to.
+type ReviewSide int
+
+// ReviewSide values.
+const (
+	ReviewSideNew ReviewSide = iota
+	ReviewSideOld
+)
+
+// String returns the string representation of ReviewSide.
+func (s ReviewSide) String() string {
+	switch s {
+	case ReviewSideOld:
+		return "old"
+	default:
+		return "new"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded ReviewSide.
+func (s ReviewSide) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded ReviewSide.
+func (s *ReviewSide) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case ReviewSideOld.String():
+		*s = ReviewSideOld
+	default:
+		*s = ReviewSideNew
+	}
+	return nil
+}
+
+// PullRequestState represents the open or closed state of
+// a pull request update.
+type PullRequestState int
+
+// PullRequestState values.
+const (
+	PullRequestStateUnknown PullRequestState = iota
+	PullRequestStateOpen
+	PullRequestStateClosed
+)
+
+// String returns the string representation of PullRequestState.
+func (s PullRequestState) String() string {
+	switch s {
+	case PullRequestStateOpen:
+		return "open"
+	case PullRequestStateClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded PullRequestState.
+func (s PullRequestState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded PullRequestState.
+func (s *PullRequestState) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case PullRequestStateOpen.String():
+		*s = PullRequestStateOpen
+	case PullRequestStateClosed.String():
+		*s = PullRequestStateClosed
+	default:
+		*s = PullRequestStateUnknown
+	}
+	return nil
+}
+
+// IssueState represents the open or closed state of an
+// issue update.
+type IssueState int
+
+// IssueState values.
+const (
+	IssueStateUnknown IssueState = iota
+	IssueStateOpen
+	IssueStateClosed
+)
+
+// String returns the string representation of IssueState.
+func (s IssueState) String() string {
+	switch s {
+	case IssueStateOpen:
+		return "open"
+	case IssueStateClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded IssueState.
+func (s IssueState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded IssueState.
+func (s *IssueState) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case IssueStateOpen.String():
+		*s = IssueStateOpen
+	case IssueStateClosed.String():
+		*s = IssueStateClosed
+	default:
+		*s = IssueStateUnknown
+	}
+	return nil
+}
+
+// MergeMethod represents the pull request merge method.
+type MergeMethod int
+
+// MergeMethod values.
+const (
+	MergeMethodDefault MergeMethod = iota
+	MergeMethodMerge
+	MergeMethodSquash
+	MergeMethodRebase
+	MergeMethodFastForward
+)
+
+// String returns the string representation of MergeMethod.
+func (m MergeMethod) String() string {
+	switch m {
+	case MergeMethodMerge:
+		return "merge"
+	case MergeMethodSquash:
+		return "squash"
+	case MergeMethodRebase:
+		return "rebase"
+	case MergeMethodFastForward:
+		return "fast_forward"
+	default:
+		return "default"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded MergeMethod.
+func (m MergeMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded MergeMethod.
+func (m *MergeMethod) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case MergeMethodMerge.String():
+		*m = MergeMethodMerge
+	case MergeMethodSquash.String():
+		*m = MergeMethodSquash
+	case MergeMethodRebase.String():
+		*m = MergeMethodRebase
+	case MergeMethodFastForward.String():
+		*m = MergeMethodFastForward
+	default:
+		*m = MergeMethodDefault
 	}
 	return nil
 }
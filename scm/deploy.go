@@ -0,0 +1,78 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Deployment represents a request to deploy a commit
+	// to a target environment.
+	Deployment struct {
+		Number      int64
+		Ref         string
+		Sha         string
+		Task        string
+		Environment string
+		Desc        string
+		Payload     interface{}
+		Author      User
+		Created     time.Time
+		Updated     time.Time
+	}
+
+	// DeploymentInput provides the input fields required
+	// for creating a deployment.
+	DeploymentInput struct {
+		Ref         string
+		Sha         string
+		Task        string
+		Environment string
+		Desc        string
+		Payload     interface{}
+	}
+
+	// DeploymentListOptions provides options for querying
+	// a list of repository deployments.
+	DeploymentListOptions struct {
+		Sha         string
+		Ref         string
+		Task        string
+		Environment string
+		Page        int
+		Size        int
+	}
+
+	// DeployStatusInput provides the input fields required
+	// for creating a deployment status.
+	DeployStatusInput struct {
+		State          State
+		Desc           string
+		Target         string
+		Environment    string
+		EnvironmentURL string
+	}
+
+	// DeploymentService provides access to repository
+	// deployment resources.
+	DeploymentService interface {
+		// Find returns the repository deployment by id.
+		Find(context.Context, string, int64) (*Deployment, *Response, error)
+
+		// List returns a list of repository deployments.
+		List(context.Context, string, DeploymentListOptions) ([]*Deployment, *Response, error)
+
+		// Create creates a new deployment.
+		Create(context.Context, string, *DeploymentInput) (*Deployment, *Response, error)
+
+		// CreateStatus creates a new deployment status.
+		CreateStatus(context.Context, string, int64, *DeployStatusInput) (*DeployStatus, *Response, error)
+
+		// ListStatuses returns a list of deployment statuses.
+		ListStatuses(context.Context, string, int64, ListOptions) ([]*DeployStatus, *Response, error)
+	}
+)
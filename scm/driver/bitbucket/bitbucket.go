@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -31,10 +32,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverBitbucket
 	client.Linker = &linker{"https://bitbucket.org/"}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
+	client.Releases = &releaseService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -65,12 +70,20 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		switch body := in.(type) {
+		case *rawBody:
+			req.Header = map[string][]string{
+				"Content-Type": {body.contentType},
+			}
+			req.Body = body.data
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header = map[string][]string{
+				"Content-Type": {"application/json"},
+			}
+			req.Body = buf
 		}
-		req.Body = buf
 	}
 
 	// execute the http request
@@ -82,12 +95,10 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 
 	// if an error is encountered, unmarshal and return the
 	// error response.
-	if res.Status == 401 {
-		return res, scm.ErrNotAuthorized
-	} else if res.Status > 300 {
+	if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, convertError(res, err)
 	}
 
 	if out == nil {
@@ -106,6 +117,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// rawBody is written to the request body as-is, without
+// json encoding. It is used to upload multipart forms.
+type rawBody struct {
+	contentType string
+	data        io.Reader
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -124,10 +142,37 @@ type link struct {
 type Error struct {
 	Type string `json:"type"`
 	Data struct {
-		Message string `json:"message"`
+		Message string              `json:"message"`
+		Detail  string              `json:"detail"`
+		Fields  map[string][]string `json:"fields"`
 	} `json:"error"`
 }
 
 func (e *Error) Error() string {
 	return e.Data.Message
 }
+
+// helper function converts the bitbucket error to the
+// common error type.
+func convertError(res *scm.Response, from *Error) *scm.Error {
+	to := &scm.Error{
+		Status:  res.Status,
+		Driver:  scm.DriverBitbucket,
+		ID:      res.Header.Get("X-Request-Id"),
+		Message: from.Data.Message,
+	}
+	var names []string
+	for name := range from.Data.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, message := range from.Data.Fields[name] {
+			to.Fields = append(to.Fields, scm.FieldError{
+				Field:   name,
+				Message: message,
+			})
+		}
+	}
+	return to
+}
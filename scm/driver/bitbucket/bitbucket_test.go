@@ -5,9 +5,14 @@
 package bitbucket
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestClient(t *testing.T) {
@@ -44,6 +49,32 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/branches").
+		Reply(400).
+		Type("application/json").
+		File("testdata/error_validation.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.CreateBranch(context.Background(), "atlassian/stash-example-plugin", "feature", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9")
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+
+	want := new(scm.Error)
+	raw, _ := ioutil.ReadFile("testdata/error_validation.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func testPage(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Page.Next, 2; got != want {
@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -37,8 +38,16 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+func (s *gitService) FindBranchProtection(ctx context.Context, repo, name string) (*scm.BranchProtection, *scm.Response, error) {
+	out, res, err := s.listRestrictions(ctx, repo, name)
+	return convertRestrictionList(name, out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches?%s", repo, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(branches)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
@@ -55,6 +64,9 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/tags?%s", repo, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(branches)
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	copyPagination(out.pagination, res)
@@ -63,6 +75,9 @@ func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOpt
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/diffstat/%s?%s", repo, ref, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(diffstats)
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	copyPagination(out.pagination, res)
@@ -71,12 +86,107 @@ func (s *gitService) ListChanges(ctx context.Context, repo, ref string, opts scm
 
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/diffstat/%s..%s?%s", repo, source, target, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(diffstats)
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	copyPagination(out.pagination, res)
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo, name, sha string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/branches", repo)
+	in := new(branchInput)
+	in.Name = name
+	in.Target.Hash = sha
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags", repo)
+	in := new(tagInput)
+	in.Name = input.Name
+	in.Message = input.Message
+	in.Target.Hash = input.Sha
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTag(out), res, err
+}
+
+// UpdateBranchProtection replaces the push, approval and build
+// restrictions matching the branch name. Existing restrictions
+// are updated in place and new restrictions are created before
+// stale restrictions are deleted, so the branch stays protected
+// if the update fails. Bitbucket does not name the builds
+// required to pass, and StatusChecks returns an OptionError.
+func (s *gitService) UpdateBranchProtection(ctx context.Context, repo, name string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	if len(input.StatusChecks) != 0 {
+		return nil, nil, &scm.OptionError{Option: "StatusChecks"}
+	}
+	existing, res, err := s.listRestrictions(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	current := map[string]*restriction{}
+	for _, v := range existing {
+		if isBranchProtection(v.Kind) && current[v.Kind] == nil {
+			current[v.Kind] = v
+		}
+	}
+	reused := map[int]bool{}
+	var updated []*restriction
+	for _, in := range convertFromBranchProtection(name, input) {
+		method := "POST"
+		path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions", repo)
+		if v, ok := current[in.Kind]; ok {
+			method = "PUT"
+			path = fmt.Sprintf("2.0/repositories/%s/branch-restrictions/%d", repo, v.ID)
+			reused[v.ID] = true
+		}
+		out := new(restriction)
+		res, err = s.client.do(ctx, method, path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		updated = append(updated, out)
+	}
+	for _, v := range existing {
+		if !isBranchProtection(v.Kind) || reused[v.ID] {
+			continue
+		}
+		path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions/%d", repo, v.ID)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return convertRestrictionList(name, updated), res, nil
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the branch restrictions matching
+// the branch name.
+func (s *gitService) listRestrictions(ctx context.Context, repo, name string) ([]*restriction, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("pattern", name)
+	params.Set("pagelen", "100")
+	path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions?%s", repo, params.Encode())
+	out := new(restrictions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return out.Values, res, err
+}
+
 type branch struct {
 	Type   string `json:"type"`
 	Name   string `json:"name"`
@@ -85,6 +195,44 @@ type branch struct {
 	} `json:"target"`
 }
 
+type branchInput struct {
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type tagInput struct {
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+	Target  struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type restrictions struct {
+	pagination
+	Values []*restriction `json:"values"`
+}
+
+type restriction struct {
+	ID              int                 `json:"id,omitempty"`
+	Kind            string              `json:"kind"`
+	BranchMatchKind string              `json:"branch_match_kind"`
+	Pattern         string              `json:"pattern"`
+	Value           int                 `json:"value,omitempty"`
+	Users           []*restrictionUser  `json:"users,omitempty"`
+	Groups          []*restrictionGroup `json:"groups,omitempty"`
+}
+
+type restrictionUser struct {
+	Username string `json:"username"`
+}
+
+type restrictionGroup struct {
+	Slug string `json:"slug"`
+}
+
 type commits struct {
 	pagination
 	Values []*commit `json:"values"`
@@ -181,6 +329,71 @@ type commit struct {
 	Type    string    `json:"type"`
 }
 
+func isBranchProtection(kind string) bool {
+	switch kind {
+	case "push", "require_approvals_to_merge", "require_passing_builds_to_merge":
+		return true
+	default:
+		return false
+	}
+}
+
+func convertRestrictionList(name string, from []*restriction) *scm.BranchProtection {
+	to := &scm.BranchProtection{Branch: name}
+	for _, v := range from {
+		switch v.Kind {
+		case "push":
+			to.RestrictPush = true
+			for _, user := range v.Users {
+				to.PushUsers = append(to.PushUsers, user.Username)
+			}
+			for _, group := range v.Groups {
+				to.PushTeams = append(to.PushTeams, group.Slug)
+			}
+		case "require_approvals_to_merge":
+			to.RequiredApprovals = v.Value
+		case "require_passing_builds_to_merge":
+			to.RequireStatusChecks = true
+		}
+	}
+	return to
+}
+
+func convertFromBranchProtection(name string, from *scm.BranchProtection) []*restriction {
+	var to []*restriction
+	if from.RestrictPush {
+		v := &restriction{
+			Kind:            "push",
+			BranchMatchKind: "glob",
+			Pattern:         name,
+		}
+		for _, user := range from.PushUsers {
+			v.Users = append(v.Users, &restrictionUser{Username: user})
+		}
+		for _, team := range from.PushTeams {
+			v.Groups = append(v.Groups, &restrictionGroup{Slug: team})
+		}
+		to = append(to, v)
+	}
+	if from.RequiredApprovals > 0 {
+		to = append(to, &restriction{
+			Kind:            "require_approvals_to_merge",
+			BranchMatchKind: "glob",
+			Pattern:         name,
+			Value:           from.RequiredApprovals,
+		})
+	}
+	if from.RequireStatusChecks {
+		to = append(to, &restriction{
+			Kind:            "require_passing_builds_to_merge",
+			BranchMatchKind: "glob",
+			Pattern:         name,
+			Value:           1,
+		})
+	}
+	return to
+}
+
 func convertDiffstats(from *diffstats) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from.Values {
@@ -230,3 +230,266 @@ func TestGitCompareChanges(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/branches").
+		JSON(map[string]interface{}{
+			"name":   "release/1.0",
+			"target": map[string]string{"hash": "a6e5e7d797edf751cbd839d6bd4aef86c941eec9"},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.CreateBranch(context.Background(), "atlassian/stash-example-plugin", "release/1.0", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/branches/release/1.0").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.DeleteBranch(context.Background(), "atlassian/stash-example-plugin", "release/1.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitFindBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.FindBranchProtection(context.Background(), "atlassian/stash-example-plugin", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_restrictions.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitUpdateBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 1, "kind": "push", "pattern": "master", "users": [{"username": "octocat"}]}`)
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/2").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 2, "kind": "require_approvals_to_merge", "pattern": "master", "value": 2}`)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/3").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.UpdateBranchProtection(context.Background(), "atlassian/stash-example-plugin", "master", &scm.BranchProtection{
+		RequiredApprovals: 2,
+		RestrictPush:      true,
+		PushUsers:         []string{"octocat"},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect existing restrictions updated and stale restrictions deleted")
+	}
+	if got, want := got.RequiredApprovals, 2; got != want {
+		t.Errorf("Want required approvals %d, got %d", want, got)
+	}
+	if got.RequireStatusChecks {
+		t.Errorf("Want required status checks removed")
+	}
+}
+
+func TestGitUpdateBranchProtection_Create(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values": []}`)
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 4, "kind": "require_approvals_to_merge", "pattern": "master", "value": 2}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "atlassian/stash-example-plugin", "master", &scm.BranchProtection{
+		RequiredApprovals: 2,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect restriction created")
+	}
+}
+
+func TestGitUpdateBranchProtection_Failure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/1").
+		Reply(403).
+		Type("application/json").
+		BodyString(`{"type": "error", "error": {"message": "Forbidden"}}`)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/3").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "atlassian/stash-example-plugin", "master", &scm.BranchProtection{
+		RestrictPush: true,
+	})
+	if err == nil {
+		t.Errorf("Expect error updating restriction")
+	}
+	if !gock.IsPending() {
+		t.Errorf("Expect restrictions not deleted after a failed update")
+	}
+}
+
+func TestGitUpdateBranchProtection_StatusChecks(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "atlassian/stash-example-plugin", "master", &scm.BranchProtection{
+		RequireStatusChecks: true,
+		StatusChecks:        []string{"ci/build"},
+	})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "StatusChecks" {
+		t.Errorf("Want StatusChecks OptionError, got %v", err)
+	}
+}
+
+func TestConvertFromBranchProtection(t *testing.T) {
+	got := convertFromBranchProtection("master", &scm.BranchProtection{
+		RequireStatusChecks: true,
+		RequiredApprovals:   2,
+		RestrictPush:        true,
+		PushUsers:           []string{"octocat"},
+		PushTeams:           []string{"justice-league"},
+	})
+	want := []*restriction{
+		{
+			Kind:            "push",
+			BranchMatchKind: "glob",
+			Pattern:         "master",
+			Users:           []*restrictionUser{{Username: "octocat"}},
+			Groups:          []*restrictionGroup{{Slug: "justice-league"}},
+		},
+		{
+			Kind:            "require_approvals_to_merge",
+			BranchMatchKind: "glob",
+			Pattern:         "master",
+			Value:           2,
+		},
+		{
+			Kind:            "require_passing_builds_to_merge",
+			BranchMatchKind: "glob",
+			Pattern:         "master",
+			Value:           1,
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/refs/tags").
+		JSON(map[string]interface{}{
+			"name":   "@atlaskit/activity@1.0.3",
+			"target": map[string]string{"hash": "ceb01356c3f062579bdfeb15bc53fe151b9e00f0"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	in := &scm.TagInput{
+		Name: "@atlaskit/activity@1.0.3",
+		Sha:  "ceb01356c3f062579bdfeb15bc53fe151b9e00f0",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.CreateTag(context.Background(), "atlassian/atlaskit", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.DeleteTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,6 +6,8 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,35 +17,83 @@ type issueService struct {
 }
 
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssue(out), res, err
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, index, id)
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues?%s", repo, encodeIssueListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(issues)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertIssueList(out), res, err
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments?%s", repo, index, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(issueComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertIssueCommentList(out), res, err
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues", repo)
+	in := new(issueInput)
+	in.Title = input.Title
+	in.Content.Raw = input.Body
+	out := new(issue)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertIssue(out), res, err
 }
 
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments", repo, number)
+	in := new(issueCommentInput)
+	in.Content.Raw = input.Body
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	in := &issueStateInput{
+		State: "closed",
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	in := &issueStateInput{
+		State: "open",
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -53,3 +103,138 @@ func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *issueService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+type issue struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	Kind    string `json:"kind"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Links struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	Reporter  user      `json:"reporter"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type issues struct {
+	pagination
+	Values []*issue `json:"values"`
+}
+
+type issueInput struct {
+	Title   string `json:"title"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+}
+
+type issueStateInput struct {
+	State string `json:"state"`
+}
+
+type issueComment struct {
+	ID      int `json:"id"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Links struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	User      user      `json:"user"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type issueComments struct {
+	pagination
+	Values []*issueComment `json:"values"`
+}
+
+type issueCommentInput struct {
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+}
+
+func convertIssueList(from *issues) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from.Values {
+		to = append(to, convertIssue(v))
+	}
+	return to
+}
+
+func convertIssue(from *issue) *scm.Issue {
+	return &scm.Issue{
+		Number: from.ID,
+		Title:  from.Title,
+		Body:   from.Content.Raw,
+		Link:   from.Links.HTML.Href,
+		Closed: isIssueClosed(from.State),
+		Author: scm.User{
+			Login:  from.Reporter.Nickname,
+			Name:   from.Reporter.DisplayName,
+			Avatar: from.Reporter.Links.Avatar.Href,
+		},
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+func convertIssueCommentList(from *issueComments) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from.Values {
+		to = append(to, convertIssueComment(v))
+	}
+	return to
+}
+
+func convertIssueComment(from *issueComment) *scm.Comment {
+	return &scm.Comment{
+		ID:   from.ID,
+		Body: from.Content.Raw,
+		Author: scm.User{
+			Login:  from.User.Nickname,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+// helper function returns true if the bitbucket issue
+// state is considered closed. The new, open and on hold
+// states are considered open.
+func isIssueClosed(state string) bool {
+	switch state {
+	case "new", "open", "on hold", "":
+		return false
+	default:
+		return true
+	}
+}
@@ -6,62 +6,286 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestIssueFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.Find(context.Background(), "", 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Find(context.Background(), "brydzewski/foo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCommentFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.FindComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues/1/comments/46981312").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.FindComment(context.Background(), "brydzewski/foo", 1, 46981312)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueList(t *testing.T) {
-	_, _, err := NewDefault().Issues.List(context.Background(), "", scm.IssueListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Issues.List(context.Background(), "brydzewski/foo", scm.IssueListOptions{Page: 1, Size: 10, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestIssueList_URL(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	opts := scm.IssueListOptions{
+		URL: "https://api.bitbucket.org/2.0/repositories/brydzewski/foo/issues?page=2",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Issues.List(context.Background(), "brydzewski/foo", opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the next page URL requested")
+	}
+}
+
+func TestIssueList_Open(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues").
+		MatchParam("q", `state = "new" OR state = "open" OR state = "on hold"`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Issues.List(context.Background(), "brydzewski/foo", scm.IssueListOptions{Open: true})
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestIssueListComments(t *testing.T) {
-	_, _, err := NewDefault().Issues.ListComments(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/issues/1/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.ListComments(context.Background(), "brydzewski/foo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/issue_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCreate(t *testing.T) {
-	_, _, err := NewDefault().Issues.Create(context.Background(), "", &scm.IssueInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/foo/issues").
+		JSON(map[string]interface{}{
+			"title": "The build is broken",
+			"content": map[string]string{
+				"raw": "The build fails on master",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := &scm.IssueInput{
+		Title: "The build is broken",
+		Body:  "The build fails on master",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Create(context.Background(), "brydzewski/foo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCreateComment(t *testing.T) {
-	_, _, err := NewDefault().Issues.CreateComment(context.Background(), "", 0, &scm.CommentInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/foo/issues/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{
+				"raw": "This is fixed on develop",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.CreateComment(context.Background(), "brydzewski/foo", 1, &scm.CommentInput{Body: "This is fixed on develop"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCommentDelete(t *testing.T) {
-	_, err := NewDefault().Issues.DeleteComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/brydzewski/foo/issues/1/comments/46981312").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	res, err := client.Issues.DeleteComment(context.Background(), "brydzewski/foo", 1, 46981312)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
 
 func TestIssueClose(t *testing.T) {
-	_, err := NewDefault().Issues.Close(context.Background(), "", 0)
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/brydzewski/foo/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.Close(context.Background(), "brydzewski/foo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/brydzewski/foo/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.Reopen(context.Background(), "brydzewski/foo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueUpdate(t *testing.T) {
+	_, _, err := NewDefault().Issues.Update(context.Background(), "", 0, &scm.IssueInput{})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
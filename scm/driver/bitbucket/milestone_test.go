@@ -0,0 +1,52 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestMilestoneFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Milestones.Find(context.Background(), "atlassian/stash", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneList(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Milestones.List(context.Background(), "atlassian/stash", scm.MilestoneListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneCreate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Milestones.Create(context.Background(), "atlassian/stash", &scm.MilestoneInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneUpdate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Milestones.Update(context.Background(), "atlassian/stash", 1, &scm.MilestoneInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneClose(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Milestones.Close(context.Background(), "atlassian/stash", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
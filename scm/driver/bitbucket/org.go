@@ -28,6 +28,9 @@ func (s *organizationService) FindMembership(ctx context.Context, name, username
 
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/teams?%s", encodeListRoleOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(organizationList)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
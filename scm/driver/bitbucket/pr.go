@@ -7,6 +7,8 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -25,6 +27,9 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests?%s", repo, encodePullRequestListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(prs)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
@@ -33,15 +38,66 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/diffstat?%s", repo, number, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(diffstats)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
 	return convertDiffstats(out), res, err
 }
 
-func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+// FindComment overrides the embedded issue service, which
+// uses the issue comment endpoints. The same applies to the
+// other pull request comment methods below.
+func (s *pullService) FindComment(ctx context.Context, repo string, number, id int) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Merge merges the pull request. Bitbucket Cloud cannot
+// check the expected head commit or merge once the build
+// passes, so those options are not supported.
+func (s *pullService) Merge(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input == nil {
+		input = new(scm.MergeInput)
+	}
+	if input.Sha != "" {
+		return nil, &scm.OptionError{Option: "Sha"}
+	}
+	if input.MergeWhenPipelineSucceeds {
+		return nil, &scm.OptionError{Option: "MergeWhenPipelineSucceeds"}
+	}
+	in := &prMergeInput{
+		Message:           input.Title,
+		CloseSourceBranch: input.DeleteSourceBranch,
+	}
+	if input.Message != "" {
+		in.Message = strings.TrimSpace(input.Title + "\n\n" + input.Message)
+	}
+	switch input.Method {
+	case scm.MergeMethodMerge:
+		in.MergeStrategy = "merge_commit"
+	case scm.MergeMethodSquash:
+		in.MergeStrategy = "squash"
+	case scm.MergeMethodRebase:
+		in.MergeStrategy = "rebase_fast_forward"
+	case scm.MergeMethodFastForward:
+		in.MergeStrategy = "fast_forward"
+	}
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/merge", repo, number)
-	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	res, err := s.client.do(ctx, "POST", path, in, nil)
 	return res, err
 }
 
@@ -49,6 +105,12 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return nil, scm.ErrNotSupported
 }
 
+// Reopen is not supported. Bitbucket Cloud cannot reopen a
+// declined pull request.
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests", repo)
 	in := new(prInput)
@@ -56,11 +118,151 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	in.Description = input.Body
 	in.Source.Branch.Name = input.Source
 	in.Destination.Branch.Name = input.Target
+	in.Draft = input.Draft
 	out := new(pr)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertPullRequest(out), res, err
 }
 
+// Update updates the pull request. The pull request state
+// cannot be changed, since Bitbucket Cloud cannot reopen a
+// declined pull request.
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	if input.State != scm.PullRequestStateUnknown {
+		return nil, nil, &scm.OptionError{Option: "State"}
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	in := &prUpdateInput{
+		Title:       input.Title,
+		Description: input.Body,
+	}
+	if input.Target != "" {
+		in.Destination = new(prDestination)
+		in.Destination.Branch.Name = input.Target
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+// RequestReviewers adds the users to the pull request
+// reviewers. Users are identified by uuid, account id or
+// nickname, and nicknames are resolved using the workspace
+// members. The reviewer list is replaced, so the pull request
+// is fetched first.
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &prReviewersInput{Reviewers: []*prReviewer{}}
+	for _, v := range current.Reviewers {
+		in.Reviewers = append(in.Reviewers, &prReviewer{UUID: v.UUID})
+	}
+	var nicknames []string
+	for _, login := range input.Users {
+		if containsReviewer(current.Reviewers, login) {
+			continue
+		}
+		if reviewer := convertFromReviewer(login); reviewer != nil {
+			in.Reviewers = append(in.Reviewers, reviewer)
+		} else {
+			nicknames = append(nicknames, login)
+		}
+	}
+	if len(nicknames) != 0 {
+		members, res, err := s.findMembers(ctx, repo, nicknames)
+		if err != nil {
+			return res, err
+		}
+		for _, v := range members {
+			in.Reviewers = append(in.Reviewers, &prReviewer{UUID: v.UUID})
+		}
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// RemoveReviewers removes the users from the pull request
+// reviewers. Users are identified by uuid, account id or
+// nickname.
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &prReviewersInput{Reviewers: []*prReviewer{}}
+	for _, v := range current.Reviewers {
+		removed := false
+		for _, login := range input.Users {
+			if isReviewer(v, login) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			in.Reviewers = append(in.Reviewers, &prReviewer{UUID: v.UUID})
+		}
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// helper function returns the workspace members with the
+// nicknames, in the same order. The member pages are fetched
+// until all nicknames are found or there are no more pages.
+func (s *pullService) findMembers(ctx context.Context, repo string, nicknames []string) ([]*user, *scm.Response, error) {
+	namespace, _ := scm.Split(repo)
+	found := map[string]*user{}
+	path := fmt.Sprintf("2.0/workspaces/%s/members?pagelen=100", namespace)
+	for {
+		out := new(members)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out.Values {
+			found[v.User.Nickname] = v.User
+		}
+		var users []*user
+		for _, nickname := range nicknames {
+			if v, ok := found[nickname]; ok {
+				users = append(users, v)
+			}
+		}
+		if len(users) == len(nicknames) {
+			return users, res, nil
+		}
+		if out.Next == "" {
+			return nil, res, scm.ErrNotFound
+		}
+		path = out.Next
+	}
+}
+
+func (s *pullService) find(ctx context.Context, repo string, number int) (*pr, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+// accountID matches Bitbucket Cloud account ids.
+var accountID = regexp.MustCompile(`^([0-9a-f]{24}|[0-9]+:[0-9a-f-]{36})$`)
+
+type members struct {
+	pagination
+	Values []struct {
+		User *user `json:"user"`
+	} `json:"values"`
+}
+
 type reference struct {
 	Commit struct {
 		Hash  string `json:"hash"`
@@ -91,6 +293,7 @@ type pr struct {
 		Diff link `json:"diff"`
 	} `json:"links"`
 	Title        string    `json:"title"`
+	Draft        bool      `json:"draft"`
 	ID           int       `json:"id"`
 	Destination  reference `json:"destination"`
 	CommentCount int       `json:"comment_count"`
@@ -103,10 +306,44 @@ type pr struct {
 	Source    reference `json:"source"`
 	State     string    `json:"state"`
 	Author    user      `json:"author"`
+	Reviewers []*user   `json:"reviewers"`
 	CreatedOn time.Time `json:"created_on"`
 	UpdatedOn time.Time `json:"updated_on"`
 }
 
+type prReviewersInput struct {
+	Reviewers []*prReviewer `json:"reviewers"`
+}
+
+type prReviewer struct {
+	UUID      string `json:"uuid,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+type prComment struct {
+	ID      int `json:"id"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Inline *struct {
+		From int    `json:"from"`
+		To   int    `json:"to"`
+		Path string `json:"path"`
+	} `json:"inline"`
+	Links struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	User      user      `json:"user"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type prMergeInput struct {
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
 type prs struct {
 	pagination
 	Values []*pr `json:"values"`
@@ -125,6 +362,19 @@ type prInput struct {
 			Name string `json:"name"`
 		} `json:"branch"`
 	} `json:"destination"`
+	Draft bool `json:"draft,omitempty"`
+}
+
+type prUpdateInput struct {
+	Title       string         `json:"title,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Destination *prDestination `json:"destination,omitempty"`
+}
+
+type prDestination struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
 }
 
 func convertPullRequests(from *prs) []*scm.PullRequest {
@@ -148,6 +398,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Diff:   from.Links.Diff.Href,
 		Closed: from.State != "OPEN",
 		Merged: from.State == "MERGED",
+		Draft:  from.Draft,
 		Head: scm.Reference{
 			Name: from.Source.Branch.Name,
 			Path: scm.ExpandRef(from.Source.Branch.Name, "refs/heads"),
@@ -163,7 +414,91 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Name:   from.Author.DisplayName,
 			Avatar: from.Author.Links.Avatar.Href,
 		},
+		Created:   from.CreatedOn,
+		Updated:   from.UpdatedOn,
+		Reviewers: convertReviewerList(from.Reviewers),
+	}
+}
+
+func convertReviewerList(from []*user) []scm.User {
+	var to []scm.User
+	for _, v := range from {
+		to = append(to, scm.User{
+			Login:  v.Nickname,
+			Name:   v.DisplayName,
+			Avatar: v.Links.Avatar.Href,
+		})
+	}
+	return to
+}
+
+// helper function returns the reviewer for the login. Uuids
+// are enclosed in braces, and account ids are either 24 hex
+// digits or a numeric prefix and a uuid, separated by a
+// colon. Other logins are nicknames, and nil is returned.
+func convertFromReviewer(login string) *prReviewer {
+	switch {
+	case strings.HasPrefix(login, "{"):
+		return &prReviewer{UUID: login}
+	case accountID.MatchString(login):
+		return &prReviewer{AccountID: login}
+	default:
+		return nil
+	}
+}
+
+// helper function returns true if the login is the uuid,
+// account id or nickname of the reviewer.
+func isReviewer(from *user, login string) bool {
+	return login == from.UUID || login == from.AccountID || login == from.Nickname
+}
+
+func containsReviewer(from []*user, login string) bool {
+	for _, v := range from {
+		if isReviewer(v, login) {
+			return true
+		}
+	}
+	return false
+}
+
+func convertPullRequestComment(from *prComment) *scm.Comment {
+	return &scm.Comment{
+		ID:   from.ID,
+		Body: from.Content.Raw,
+		Author: scm.User{
+			Login:  from.User.Nickname,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+// helper function converts an inline pull request comment
+// to a review comment. Comments on removed lines only have
+// a line number on the old (from) side of the diff.
+func convertPullRequestInlineComment(from *prComment) *scm.Review {
+	dst := &scm.Review{
+		ID:   from.ID,
+		Body: from.Content.Raw,
+		Link: from.Links.HTML.Href,
+		Author: scm.User{
+			Login:  from.User.Nickname,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
 		Created: from.CreatedOn,
 		Updated: from.UpdatedOn,
 	}
+	if from.Inline != nil {
+		dst.Path = from.Inline.Path
+		dst.Line = from.Inline.To
+		if dst.Line == 0 {
+			dst.Line = from.Inline.From
+			dst.Side = scm.ReviewSideOld
+		}
+	}
+	return dst
 }
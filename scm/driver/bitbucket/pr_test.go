@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -68,6 +69,30 @@ func TestPullList(t *testing.T) {
 	}
 }
 
+func TestPullList_URL(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	opts := scm.PullRequestListOptions{
+		URL: "https://api.bitbucket.org/2.0/repositories/atlassian/atlaskit/pullrequests?page=2",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.PullRequests.List(context.Background(), "atlassian/atlaskit", opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the next page URL requested")
+	}
+}
+
 func TestPullListChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -104,12 +129,53 @@ func TestPullMerge(t *testing.T) {
 		Type("application/json")
 
 	client, _ := New("https://api.bitbucket.org")
-	_, err := client.PullRequests.Merge(context.Background(), "atlassian/atlaskit", 1)
+	_, err := client.PullRequests.Merge(context.Background(), "atlassian/atlaskit", 1, nil)
 	if err != nil {
 		t.Error(err)
 	}
 }
 
+func TestPullMerge_Options(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("2.0/repositories/atlassian/atlaskit/pullrequests/1/merge").
+		JSON(map[string]interface{}{
+			"message":             "Add feature\n\nDetails",
+			"close_source_branch": true,
+			"merge_strategy":      "squash",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		Title:              "Add feature",
+		Message:            "Details",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.Merge(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullMerge_NotSupported(t *testing.T) {
+	inputs := []*scm.MergeInput{
+		{Sha: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"},
+		{MergeWhenPipelineSucceeds: true},
+	}
+	client, _ := New("https://api.bitbucket.org")
+	for _, input := range inputs {
+		_, err := client.PullRequests.Merge(context.Background(), "atlassian/atlaskit", 1, input)
+		if !errors.Is(err, scm.ErrNotSupported) {
+			t.Errorf("Expect Not Supported error, got %v", err)
+		}
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	client, _ := New("https://api.bitbucket.org")
 	_, err := client.PullRequests.Close(context.Background(), "atlassian/atlaskit", 1)
@@ -118,20 +184,250 @@ func TestPullClose(t *testing.T) {
 	}
 }
 
+func TestPullReopen(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.Reopen(context.Background(), "atlassian/atlaskit", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"title": "IOS date picker component duplicate March issue",
+			"destination": map[string]interface{}{
+				"branch": map[string]string{"name": "master"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_draft.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Title:  "IOS date picker component duplicate March issue",
+		Target: "master",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.PullRequests.Update(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr_draft.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullUpdate_State(t *testing.T) {
+	input := &scm.PullRequestUpdateInput{State: scm.PullRequestStateOpen}
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.PullRequests.Update(context.Background(), "atlassian/atlaskit", 1, input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		JSON(map[string]interface{}{
+			"reviewers": []map[string]string{
+				{"uuid": "{d301aafa-d676-4ee0-88be-962be7417567}"},
+				{"account_id": "5c7c7b1a0b79db7c3e33eca2"},
+				{"uuid": "{a6e3b1a7-4c4e-4c61-9f4f-8c6f2b2fd3e1}"},
+			},
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{
+			"5b68dd2b52c9f62d7e1b5e9f",
+			"5c7c7b1a0b79db7c3e33eca2",
+			"{a6e3b1a7-4c4e-4c61-9f4f-8c6f2b2fd3e1}",
+		},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "atlassian/atlaskit", 4982, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestReviewers_Nickname(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/members").
+		MatchParam("pagelen", "100").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values": [{"user": {"nickname": "jcitizen", "uuid": "{d301aafa-d676-4ee0-88be-962be7417567}"}}], "next": "https://api.bitbucket.org/2.0/workspaces/atlassian/members?pagelen=100&page=2"}`)
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/members").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values": [{"user": {"nickname": "jsmith", "uuid": "{a6e3b1a7-4c4e-4c61-9f4f-8c6f2b2fd3e1}"}}]}`)
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		JSON(map[string]interface{}{
+			"reviewers": []map[string]string{
+				{"uuid": "{d301aafa-d676-4ee0-88be-962be7417567}"},
+				{"uuid": "{a6e3b1a7-4c4e-4c61-9f4f-8c6f2b2fd3e1}"},
+			},
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "atlassian/atlaskit", 4982, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestReviewers_UnknownNickname(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/members").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values": []}`)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"nobody"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "atlassian/atlaskit", 4982, input)
+	if err != scm.ErrNotFound {
+		t.Errorf("Want Not Found error, got %v", err)
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		JSON(map[string]interface{}{
+			"reviewers": []map[string]string{},
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"{d301aafa-d676-4ee0-88be-962be7417567}"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "atlassian/atlaskit", 4982, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestReviewers_Teams(t *testing.T) {
+	input := &scm.ReviewerInput{Teams: []string{"developers"}}
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "atlassian/atlaskit", 4982, input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullAddAssignees(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.AddAssignees(context.Background(), "atlassian/atlaskit", 4982, []string{"jcitizen"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.bitbucket.org").
 		Post("/2.0/repositories/atlassian/atlaskit/pullrequests").
+		JSON(map[string]interface{}{
+			"title":       "IOS date picker component duplicate March issue",
+			"description": "IOS date picker component duplicate March issue",
+			"source": map[string]interface{}{
+				"branch": map[string]string{"name": "Lachlan-Vass/ios-date-picker-component-duplicate-marc-1579222909688"},
+			},
+			"destination": map[string]interface{}{
+				"branch": map[string]string{"name": "master"},
+			},
+			"draft": true,
+		}).
 		Reply(201).
 		Type("application/json").
-		File("testdata/pr.json")
+		File("testdata/pr_draft.json")
 
 	input := &scm.PullRequestInput{
 		Title:  "IOS date picker component duplicate March issue",
 		Body:   "IOS date picker component duplicate March issue",
 		Source: "Lachlan-Vass/ios-date-picker-component-duplicate-marc-1579222909688",
 		Target: "master",
+		Draft:  true,
 	}
 
 	client, _ := New("https://api.bitbucket.org")
@@ -141,7 +437,7 @@ func TestPullCreate(t *testing.T) {
 	}
 
 	want := new(scm.PullRequest)
-	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	raw, _ := ioutil.ReadFile("testdata/pr_draft.json.golden")
 	json.Unmarshal(raw, want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -149,3 +445,10 @@ func TestPullCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullListComments(t *testing.T) {
+	_, _, err := NewDefault().PullRequests.ListComments(context.Background(), "atlassian/atlaskit", 4982, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// releaseService implements the release service. Bitbucket
+// does not support releases; release assets are uploaded to
+// the repository downloads.
+type releaseService struct {
+	client *wrapper
+}
+
+func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) FindByTag(ctx context.Context, repo, tag string) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UpdateByTag(ctx context.Context, repo, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DeleteByTag(ctx context.Context, repo, tag string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// UploadAsset uploads the file to the repository downloads.
+// The release id is ignored.
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, id int, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/downloads", repo)
+	buf := new(bytes.Buffer)
+	form := multipart.NewWriter(buf)
+	part, err := form.CreateFormFile("files", input.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	size, err := io.Copy(part, input.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, nil, err
+	}
+	in := &rawBody{
+		contentType: form.FormDataContentType(),
+		data:        buf,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.ReleaseAsset{
+		Name:        input.Name,
+		Size:        size,
+		ContentType: input.ContentType,
+	}, res, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Releases.Find(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Releases.List(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Releases.Create(context.Background(), "atlassian/stash-example-plugin", &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/downloads").
+		MatchHeader("Content-Type", "multipart/form-data").
+		Reply(201)
+
+	in := &scm.ReleaseAssetInput{
+		Name:        "example.txt",
+		ContentType: "text/plain",
+		Data:        strings.NewReader("hello world"),
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.UploadAsset(context.Background(), "atlassian/stash-example-plugin", 0, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.ReleaseAsset{
+		Name:        "example.txt",
+		Size:        11,
+		ContentType: "text/plain",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
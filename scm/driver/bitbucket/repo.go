@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -65,6 +66,23 @@ type hookInput struct {
 	Events               []string `json:"events"`
 }
 
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+type key struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	Label     string    `json:"label"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+type keyInput struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -85,6 +103,14 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+// FindKey returns a repository access key.
+func (s *repositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 // FindPerms returns the repository permissions.
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/user/permissions/repositories?q=repository.full_name=%q", repo)
@@ -108,15 +134,33 @@ func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*
 // ListHooks returns a list or repository hooks.
 func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/hooks?%s", repo, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(hooks)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
 	return convertHookList(out), res, err
 }
 
+// ListKeys returns a list of repository access keys.
+func (s *repositoryService) ListKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys?%s", repo, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(keys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertKeyList(out), res, err
+}
+
 // ListStatus returns a list of commit statuses.
 func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/commit/%s/statuses?%s", repo, ref, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
 	out := new(statuses)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
@@ -148,6 +192,23 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateKey creates a new repository access key. Bitbucket
+// access keys are always read-only, and a key with write
+// access returns an OptionError.
+func (s *repositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	if !input.ReadOnly {
+		return nil, nil, &scm.OptionError{Option: "ReadOnly"}
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys", repo)
+	in := &keyInput{
+		Key:   input.Key,
+		Label: input.Title,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 // CreateStatus creates a new commit status.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/commit/%s/statuses/build", repo, ref)
@@ -194,6 +255,12 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// DeleteKey deletes a repository access key.
+func (s *repositoryService) DeleteKey(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -280,6 +347,24 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(from *keys) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Label,
+		Key:      from.Key,
+		ReadOnly: true,
+		Created:  from.CreatedOn,
+	}
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -136,6 +136,47 @@ func TestRepositoryList(t *testing.T) {
 	}
 }
 
+func TestRepositoryListAll(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories").
+		MatchParam("after", "PLACEHOLDER").
+		MatchParam("pagelen", "1").
+		MatchParam("role", "member").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos-2.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories").
+		MatchParam("pagelen", "1").
+		MatchParam("role", "member").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	pager := &scm.Pager{Size: 1}
+	got := []*scm.Repository{}
+	err := pager.All(context.Background(), &got, func(ctx context.Context, opts scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.List(ctx, opts)
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestStatusList(t *testing.T) {
 	defer gock.Off()
 
@@ -424,3 +465,114 @@ func TestConvertPerms(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys/123").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.FindKey(context.Background(), "atlassian/stash-example-plugin", "123")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.ListKeys(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		File("testdata/key_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	in := &scm.DeployKeyInput{
+		Title:    "mykey",
+		Key:      "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.CreateKey(context.Background(), "atlassian/stash-example-plugin", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyCreate_ReadWrite(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Repositories.CreateKey(context.Background(), "atlassian/stash-example-plugin", &scm.DeployKeyInput{
+		Title: "deploy",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+	})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "ReadOnly" {
+		t.Errorf("Want ReadOnly OptionError, got %v", err)
+	}
+}
+
+func TestRepositoryKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys/123").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.DeleteKey(context.Background(), "atlassian/stash-example-plugin", "123")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,6 +6,8 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +17,210 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	out := new(prComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertPullRequestInlineComment(out), res, err
 }
 
+// List returns the inline pull request comments. General
+// pull request comments are not included.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(prComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertPullRequestInlineCommentList(out), res, err
 }
 
+// Create adds an inline comment to the pull request diff.
+// The line is anchored to the old (from) side of the diff
+// for comments on removed lines, and to the new (to) side
+// otherwise.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := new(prCommentInput)
+	in.Content.Raw = input.Body
+	in.Inline.Path = input.Path
+	if input.Side == scm.ReviewSideOld {
+		in.Inline.From = input.Line
+	} else {
+		in.Inline.To = input.Line
+	}
+	out := new(prComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPullRequestInlineComment(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ListSummaries returns the pull request participants that
+// approved or requested changes. The participants are not
+// paginated, so the list options are ignored.
+func (s *reviewService) ListSummaries(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertParticipantList(out), res, err
+}
+
+// Submit approves, requests changes to, or comments on the
+// pull request. The review body is added as a pull request
+// comment.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSummaryInput) (*scm.ReviewSummary, *scm.Response, error) {
+	var action string
+	switch input.State {
+	case scm.ReviewStateApproved:
+		action = "approve"
+	case scm.ReviewStateChangesRequested:
+		action = "request-changes"
+	case scm.ReviewStateCommented:
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	if input.State == scm.ReviewStateCommented && input.Body == "" && len(input.Comments) == 0 {
+		return nil, nil, &scm.OptionError{Option: "Body"}
+	}
+	var res *scm.Response
+	var err error
+	for _, comment := range input.Comments {
+		if _, res, err = s.Create(ctx, repo, number, comment); err != nil {
+			return nil, res, err
+		}
+	}
+	if input.Body != "" {
+		path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+		in := new(issueCommentInput)
+		in.Content.Raw = input.Body
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	out := &scm.ReviewSummary{
+		State: input.State,
+		Body:  input.Body,
+		Sha:   input.Sha,
+	}
+	if action == "" {
+		return out, res, nil
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/%s", repo, number, action)
+	approval := new(participant)
+	res, err = s.client.do(ctx, "POST", path, nil, approval)
+	out.Author = convertParticipant(approval).Author
+	out.Created = approval.ParticipatedOn
+	return out, res, err
+}
+
+// Dismiss removes the pull request approval or change
+// request of the authenticated user, based on the current
+// participant state. The review id and message are ignored.
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	self := new(user)
+	res, err := s.client.do(ctx, "GET", "2.0/user", nil, self)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out.Participants {
+		if v.User.UUID != self.UUID {
+			continue
+		}
+		switch convertParticipant(v).State {
+		case scm.ReviewStateApproved:
+			return s.client.do(ctx, "DELETE", path+"/approve", nil, nil)
+		case scm.ReviewStateChangesRequested:
+			return s.client.do(ctx, "DELETE", path+"/request-changes", nil, nil)
+		}
+	}
+	return res, scm.ErrNotFound
+}
+
+func (s *reviewService) FindApprovals(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type prComments struct {
+	pagination
+	Values []*prComment `json:"values"`
+}
+
+type prCommentInput struct {
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Inline struct {
+		Path string `json:"path"`
+		From int    `json:"from,omitempty"`
+		To   int    `json:"to,omitempty"`
+	} `json:"inline"`
+}
+
+type prParticipants struct {
+	Participants []*participant `json:"participants"`
+}
+
+type participant struct {
+	User           user      `json:"user"`
+	Role           string    `json:"role"`
+	Approved       bool      `json:"approved"`
+	State          string    `json:"state"`
+	ParticipatedOn time.Time `json:"participated_on"`
+}
+
+// helper function returns the inline comments, skipping
+// the general pull request comments.
+func convertPullRequestInlineCommentList(from *prComments) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from.Values {
+		if v.Inline == nil {
+			continue
+		}
+		to = append(to, convertPullRequestInlineComment(v))
+	}
+	return to
+}
+
+// helper function returns the participants that approved
+// or requested changes to the pull request.
+func convertParticipantList(from *prParticipants) []*scm.ReviewSummary {
+	to := []*scm.ReviewSummary{}
+	for _, v := range from.Participants {
+		review := convertParticipant(v)
+		if review.State == scm.ReviewStateUnknown {
+			continue
+		}
+		to = append(to, review)
+	}
+	return to
+}
+
+func convertParticipant(from *participant) *scm.ReviewSummary {
+	to := &scm.ReviewSummary{
+		Author: scm.User{
+			Login:  from.User.Nickname,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
+		Created: from.ParticipatedOn,
+	}
+	switch {
+	case from.State == "changes_requested":
+		to.State = scm.ReviewStateChangesRequested
+	case from.State == "approved", from.Approved:
+		to.State = scm.ReviewStateApproved
+	}
+	return to
 }
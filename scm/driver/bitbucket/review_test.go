@@ -6,35 +6,327 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/pullrequests/1/comments/66340311").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Find(context.Background(), "brydzewski/foo", 1, 66340311)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/pullrequests/1/comments").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.List(context.Background(), "brydzewski/foo", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/pr_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewList_URL(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brydzewski/foo/pullrequests/1/comments").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comments.json")
+
+	opts := scm.ListOptions{
+		URL: "https://api.bitbucket.org/2.0/repositories/brydzewski/foo/pullrequests/1/comments?page=2",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.List(context.Background(), "brydzewski/foo", 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the next page URL requested")
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/foo/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Please use a constant here."},
+			"inline":  map[string]interface{}{"path": "README.md", "to": 12},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	input := &scm.ReviewInput{
+		Body: "Please use a constant here.",
+		Path: "README.md",
+		Line: 12,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Create(context.Background(), "brydzewski/foo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate_Old(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/foo/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "please fix the typo"},
+			"inline":  map[string]interface{}{"path": "README.md", "from": 3},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	input := &scm.ReviewInput{
+		Body: "please fix the typo",
+		Path: "README.md",
+		Line: 3,
+		Side: scm.ReviewSideOld,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Create(context.Background(), "brydzewski/foo", 1, input)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/brydzewski/foo/pullrequests/1/comments/66340311").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Delete(context.Background(), "brydzewski/foo", 1, 66340311)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewListSummaries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.ListSummaries(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/pr_participants.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "lgtm"},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString("{}")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/approve").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateApproved,
+		Body:  "lgtm",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/pr_participants.json.golden")
+	json.Unmarshal(raw, &want)
+	want[0].Body = "lgtm"
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestReviewSubmit_ChangesRequested(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/request-changes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateChangesRequested,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewSubmit_Empty(t *testing.T) {
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateCommented,
+	}
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Body" {
+		t.Errorf("Want Body OptionError, got %v", err)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	tests := []struct {
+		uuid   string
+		action string
+	}{
+		{"{d301aafa-d676-4ee0-88be-962be7417567}", "approve"},
+		{"{a6e3b1a7-4c4e-4c61-9f4f-8c6f2b2fd3e1}", "request-changes"},
+	}
+	for _, test := range tests {
+		gock.New("https://api.bitbucket.org").
+			Get("/2.0/user").
+			Reply(200).
+			Type("application/json").
+			BodyString(fmt.Sprintf(`{"uuid": %q}`, test.uuid))
+
+		gock.New("https://api.bitbucket.org").
+			Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+			Reply(200).
+			Type("application/json").
+			File("testdata/pr_participants.json")
+
+		gock.New("https://api.bitbucket.org").
+			Delete("/2.0/repositories/atlassian/atlaskit/pullrequests/1/" + test.action).
+			Reply(204)
+
+		client, _ := New("https://api.bitbucket.org")
+		_, err := client.Reviews.Dismiss(context.Background(), "atlassian/atlaskit", 1, 0, "")
+		if err != nil {
+			t.Error(err)
+		}
+		if !gock.IsDone() {
+			t.Errorf("Expect %s removed", test.action)
+		}
+		gock.Off()
+	}
+}
+
+func TestReviewDismiss_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Dismiss(context.Background(), "atlassian/atlaskit", 1, 0, "")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want Not Found error, got %v", err)
 	}
 }
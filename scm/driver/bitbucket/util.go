@@ -66,10 +66,12 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
-	if opts.Open && opts.Closed {
-		params.Set("state", "all")
-	} else if opts.Closed {
-		params.Set("state", "closed")
+	// the issue states are filtered with a query, which
+	// is omitted when both open and closed issues are listed.
+	if !opts.Closed {
+		params.Set("q", `state = "new" OR state = "open" OR state = "on hold"`)
+	} else if !opts.Open {
+		params.Set("q", `state != "new" AND state != "open" AND state != "on hold"`)
 	}
 	return params.Encode()
 }
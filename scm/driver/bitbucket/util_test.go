@@ -42,7 +42,18 @@ func Test_encodeIssueListOptions(t *testing.T) {
 		Open:   true,
 		Closed: true,
 	}
-	want := "page=10&pagelen=30&state=all"
+	want := "page=10&pagelen=30"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Closed(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Closed: true,
+	}
+	want := "q=state+%21%3D+%22new%22+AND+state+%21%3D+%22open%22+AND+state+%21%3D+%22on+hold%22"
 	got := encodeIssueListOptions(opts)
 	if got != want {
 		t.Errorf("Want encoded issue list options %q, got %q", want, got)
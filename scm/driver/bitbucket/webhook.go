@@ -37,29 +37,35 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "repo:push":
 		hook, err = s.parsePushHook(data)
 	case "pullrequest:created":
-		hook, err = s.parsePullRequestHook(data)
+		hook, err = s.parsePullRequestHook(data, scm.ActionOpen)
 	case "pullrequest:updated":
-		hook, err = s.parsePullRequestHook(data)
-		if hook != nil {
-			hook.(*scm.PullRequestHook).Action = scm.ActionSync
-		}
+		hook, err = s.parsePullRequestHook(data, scm.ActionSync)
 	case "pullrequest:fulfilled":
-		hook, err = s.parsePullRequestHook(data)
-		if hook != nil {
-			hook.(*scm.PullRequestHook).Action = scm.ActionMerge
-		}
+		hook, err = s.parsePullRequestHook(data, scm.ActionMerge)
 	case "pullrequest:rejected":
-		hook, err = s.parsePullRequestHook(data)
-		if hook != nil {
-			hook.(*scm.PullRequestHook).Action = scm.ActionClose
-		}
+		hook, err = s.parsePullRequestHook(data, scm.ActionClose)
+	case "pullrequest:approved":
+		hook, err = s.parsePullRequestHook(data, scm.ActionApprove)
+	case "pullrequest:unapproved":
+		hook, err = s.parsePullRequestHook(data, scm.ActionUnapprove)
+	case "pullrequest:comment_created":
+		hook, err = s.parsePullRequestCommentHook(data, scm.ActionCreate)
+	case "pullrequest:comment_updated":
+		hook, err = s.parsePullRequestCommentHook(data, scm.ActionUpdate)
+	case "pullrequest:comment_deleted":
+		hook, err = s.parsePullRequestCommentHook(data, scm.ActionDelete)
+	case "issue:created":
+		hook, err = s.parseIssueHook(data, scm.ActionOpen)
+	case "issue:updated":
+		hook, err = s.parseIssueHook(data, scm.ActionUpdate)
+	case "issue:comment_created":
+		hook, err = s.parseIssueCommentHook(data)
+	default:
+		return nil, scm.ErrUnknownEvent
 	}
 	if err != nil {
 		return nil, err
 	}
-	if hook == nil {
-		return nil, nil
-	}
 
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -102,17 +108,64 @@ func (s *webhookService) parsePushHook(data []byte) (scm.Webhook, error) {
 	}
 }
 
-func (s *webhookService) parsePullRequestHook(data []byte) (*scm.PullRequestHook, error) {
+func (s *webhookService) parsePullRequestHook(data []byte, action scm.Action) (scm.Webhook, error) {
 	dst := new(webhook)
 	err := json.Unmarshal(data, dst)
 	if err != nil {
 		return nil, err
 	}
+	hook := convertPullRequestHook(dst)
+	hook.Action = action
+	return hook, nil
+}
 
-	switch {
-	default:
-		return convertPullRequestHook(dst), err
+func (s *webhookService) parsePullRequestCommentHook(data []byte, action scm.Action) (scm.Webhook, error) {
+	dst := new(prCommentHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
 	}
+	// inline comments are attached to a file and line
+	// in the diff, and are therefore review comments.
+	if dst.Comment.Inline != nil {
+		hook := convertReviewCommentHook(dst)
+		hook.Action = action
+		return hook, nil
+	}
+	hook := convertPullRequestCommentHook(dst)
+	hook.Action = action
+	return hook, nil
+}
+
+func (s *webhookService) parseIssueHook(data []byte, action scm.Action) (scm.Webhook, error) {
+	dst := new(issueHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	hook := convertIssueHook(dst)
+	hook.Action = action
+	// bitbucket does not send a dedicated event when an
+	// issue is closed or reopened. Instead we inspect the
+	// state change included in the update payload.
+	if change := dst.Changes.State; change != nil && action == scm.ActionUpdate {
+		switch {
+		case isIssueClosed(change.New) && !isIssueClosed(change.Old):
+			hook.Action = scm.ActionClose
+		case !isIssueClosed(change.New) && isIssueClosed(change.Old):
+			hook.Action = scm.ActionReopen
+		}
+	}
+	return hook, nil
+}
+
+func (s *webhookService) parseIssueCommentHook(data []byte) (scm.Webhook, error) {
+	dst := new(issueHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return convertIssueCommentHook(dst), nil
 }
 
 //
@@ -346,6 +399,26 @@ type (
 		Actor       webhookActor      `json:"actor"`
 	}
 
+	prCommentHook struct {
+		Comment     prComment         `json:"comment"`
+		PullRequest pr                `json:"pullrequest"`
+		Repository  webhookRepository `json:"repository"`
+		Actor       webhookActor      `json:"actor"`
+	}
+
+	issueHook struct {
+		Issue   issue        `json:"issue"`
+		Comment issueComment `json:"comment"`
+		Changes struct {
+			State *struct {
+				Old string `json:"old"`
+				New string `json:"new"`
+			} `json:"state"`
+		} `json:"changes"`
+		Repository webhookRepository `json:"repository"`
+		Actor      webhookActor      `json:"actor"`
+	}
+
 	webhookRepository struct {
 		Scm   string `json:"scm"`
 		Name  string `json:"name"`
@@ -572,27 +645,127 @@ func convertTagDeleteHook(src *pushHook) *scm.TagHook {
 func convertPullRequestHook(src *webhook) *scm.PullRequestHook {
 	namespace, name := scm.Split(src.Repository.FullName)
 	return &scm.PullRequestHook{
-		Action: scm.ActionOpen,
-		PullRequest: scm.PullRequest{
-			Number: src.PullRequest.ID,
-			Title:  src.PullRequest.Title,
-			Body:   src.PullRequest.Description,
-			Sha:    src.PullRequest.Source.Commit.Hash,
-			Ref:    fmt.Sprintf("refs/pull-requests/%d/from", src.PullRequest.ID),
-			Source: src.PullRequest.Source.Branch.Name,
-			Target: src.PullRequest.Destination.Branch.Name,
-			Fork:   src.PullRequest.Source.Repository.FullName,
-			Link:   src.PullRequest.Links.HTML.Href,
-			Closed: src.PullRequest.State != "OPEN",
-			Merged: src.PullRequest.State == "MERGED",
-			Author: scm.User{
-				Login:  src.PullRequest.Author.Username,
-				Name:   src.PullRequest.Author.DisplayName,
-				Avatar: src.PullRequest.Author.Links.Avatar.Href,
-			},
-			Created: src.PullRequest.CreatedOn,
-			Updated: src.PullRequest.UpdatedOn,
+		Action:      scm.ActionOpen,
+		PullRequest: convertWebhookPullRequest(&src.PullRequest),
+		Repo: scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		},
+		Sender: scm.User{
+			Login:  src.Actor.Username,
+			Name:   src.Actor.DisplayName,
+			Avatar: src.Actor.Links.Avatar.Href,
 		},
+	}
+}
+
+func convertWebhookPullRequest(src *pr) scm.PullRequest {
+	return scm.PullRequest{
+		Number: src.ID,
+		Title:  src.Title,
+		Body:   src.Description,
+		Sha:    src.Source.Commit.Hash,
+		Ref:    fmt.Sprintf("refs/pull-requests/%d/from", src.ID),
+		Source: src.Source.Branch.Name,
+		Target: src.Destination.Branch.Name,
+		Fork:   src.Source.Repository.FullName,
+		Link:   src.Links.HTML.Href,
+		Closed: src.State != "OPEN",
+		Merged: src.State == "MERGED",
+		Author: scm.User{
+			Login:  src.Author.Username,
+			Name:   src.Author.DisplayName,
+			Avatar: src.Author.Links.Avatar.Href,
+		},
+		Created: src.CreatedOn,
+		Updated: src.UpdatedOn,
+	}
+}
+
+//
+// pull request comment hooks
+//
+
+func convertPullRequestCommentHook(src *prCommentHook) *scm.PullRequestCommentHook {
+	namespace, name := scm.Split(src.Repository.FullName)
+	return &scm.PullRequestCommentHook{
+		PullRequest: convertWebhookPullRequest(&src.PullRequest),
+		Comment:     *convertPullRequestComment(&src.Comment),
+		Repo: scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		},
+		Sender: scm.User{
+			Login:  src.Actor.Username,
+			Name:   src.Actor.DisplayName,
+			Avatar: src.Actor.Links.Avatar.Href,
+		},
+	}
+}
+
+func convertReviewCommentHook(src *prCommentHook) *scm.ReviewCommentHook {
+	namespace, name := scm.Split(src.Repository.FullName)
+	return &scm.ReviewCommentHook{
+		PullRequest: convertWebhookPullRequest(&src.PullRequest),
+		Review:      *convertPullRequestInlineComment(&src.Comment),
+		Repo: scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		},
+		Sender: scm.User{
+			Login:  src.Actor.Username,
+			Name:   src.Actor.DisplayName,
+			Avatar: src.Actor.Links.Avatar.Href,
+		},
+	}
+}
+
+//
+// issue hooks
+//
+
+func convertIssueHook(src *issueHook) *scm.IssueHook {
+	namespace, name := scm.Split(src.Repository.FullName)
+	return &scm.IssueHook{
+		Issue: *convertIssue(&src.Issue),
+		Repo: scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		},
+		Sender: scm.User{
+			Login:  src.Actor.Username,
+			Name:   src.Actor.DisplayName,
+			Avatar: src.Actor.Links.Avatar.Href,
+		},
+	}
+}
+
+func convertIssueCommentHook(src *issueHook) *scm.IssueCommentHook {
+	namespace, name := scm.Split(src.Repository.FullName)
+	return &scm.IssueCommentHook{
+		Action:  scm.ActionCreate,
+		Issue:   *convertIssue(&src.Issue),
+		Comment: *convertIssueComment(&src.Comment),
 		Repo: scm.Repository{
 			ID:        src.Repository.UUID,
 			Namespace: namespace,
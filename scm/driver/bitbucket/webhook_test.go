@@ -116,6 +116,64 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_declined.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:approved",
+			before: "testdata/webhooks/pr_approved.json",
+			after:  "testdata/webhooks/pr_approved.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+
+		//
+		// pull request comment events
+		//
+
+		// pull request comment created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:comment_created",
+			before: "testdata/webhooks/pr_comment_created.json",
+			after:  "testdata/webhooks/pr_comment_created.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request inline comment updated
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:comment_updated",
+			before: "testdata/webhooks/pr_comment_inline.json",
+			after:  "testdata/webhooks/pr_comment_inline.json.golden",
+			obj:    new(scm.ReviewCommentHook),
+		},
+
+		//
+		// issue events
+		//
+
+		// issue created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:created",
+			before: "testdata/webhooks/issue_created.json",
+			after:  "testdata/webhooks/issue_created.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue updated (resolved)
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:updated",
+			before: "testdata/webhooks/issue_updated.json",
+			after:  "testdata/webhooks/issue_updated.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue comment created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:comment_created",
+			before: "testdata/webhooks/issue_comment_created.json",
+			after:  "testdata/webhooks/issue_comment_created.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
 		// 		// pull request labeled
 		// 		{
 		// 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
@@ -188,6 +246,29 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhook_ErrUnknownEvent(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBuffer(f))
+	r.Header.Set("x-event-key", "repo:fork")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Malformed(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBufferString("{"))
+	r.Header.Set("x-event-key", "pullrequest:approved")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err == nil {
+		t.Errorf("Expect error parsing malformed payload")
+	}
+}
+
 func TestWebhookInvalid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
 	r, _ := http.NewRequest("GET", "/?secret=xxxxxinvalidxxxxxx", bytes.NewBuffer(f))
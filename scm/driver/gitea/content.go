@@ -27,15 +27,32 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := convertContentParams(params)
+	return s.client.do(ctx, "POST", endpoint, in, nil)
 }
 
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := convertContentParams(params)
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
 }
 
+// Delete deletes the file from the branch. Gitea requires
+// the blob sha of the file, so the file is looked up first.
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s?ref=%s", repo, path, ref)
+	out := new(content)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return res, err
+	}
+	endpoint = fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentInput{
+		Branch: ref,
+		Sha:    out.Sha,
+	}
+	return s.client.do(ctx, "DELETE", endpoint, in, nil)
 }
 
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
@@ -48,6 +65,38 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 type content struct {
 	Path string `json:"path"`
 	Type string `json:"type"`
+	Sha  string `json:"sha"`
+}
+
+type contentInput struct {
+	Branch    string        `json:"branch,omitempty"`
+	Message   string        `json:"message,omitempty"`
+	Content   []byte        `json:"content,omitempty"`
+	Sha       string        `json:"sha,omitempty"`
+	Author    *commitAuthor `json:"author,omitempty"`
+	Committer *commitAuthor `json:"committer,omitempty"`
+}
+
+type commitAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func convertContentParams(from *scm.ContentParams) *contentInput {
+	to := &contentInput{
+		Branch:  from.Branch,
+		Message: from.Message,
+		Content: from.Data,
+		Sha:     from.Sha,
+	}
+	if from.Signature.Email != "" {
+		to.Author = &commitAuthor{
+			Name:  from.Signature.Name,
+			Email: from.Signature.Email,
+		}
+		to.Committer = to.Author
+	}
+	return to
 }
 
 func convertContentInfoList(from []*content) []*scm.ContentInfo {
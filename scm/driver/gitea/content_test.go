@@ -44,26 +44,109 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]interface{}{
+			"branch":  "master",
+			"message": "create a new file",
+			"content": "SGVsbG8gV29ybGQK",
+			"author": map[string]string{
+				"name":  "Lunny Xiao",
+				"email": "xiaolunwen@gmail.com",
+			},
+			"committer": map[string]string{
+				"name":  "Lunny Xiao",
+				"email": "xiaolunwen@gmail.com",
+			},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "create a new file",
+		Data:    []byte("Hello World\n"),
+		Signature: scm.Signature{
+			Name:  "Lunny Xiao",
+			Email: "xiaolunwen@gmail.com",
+		},
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 201; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]interface{}{
+			"branch":  "master",
+			"message": "update a file",
+			"content": "SGVsbG8gV29ybGQK",
+			"sha":     "980a0d5f19a64b4b30a87d4206aade58726b60e3",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "update a file",
+		Data:    []byte("Hello World\n"),
+		Sha:     "980a0d5f19a64b4b30a87d4206aade58726b60e3",
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "README.md", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
 
 func TestContentDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]string{
+			"branch": "master",
+			"sha":    "980a0d5f19a64b4b30a87d4206aade58726b60e3",
+		}).
+		Reply(200).
+		Type("application/json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "README.md", "master")
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "README.md", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
 	}
 }
 
@@ -0,0 +1,52 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deployments.Find(context.Background(), "go-gitea/gitea", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentList(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deployments.List(context.Background(), "go-gitea/gitea", scm.DeploymentListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deployments.Create(context.Background(), "go-gitea/gitea", &scm.DeploymentInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deployments.CreateStatus(context.Background(), "go-gitea/gitea", 1, &scm.DeployStatusInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentListStatuses(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deployments.ListStatuses(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -6,6 +6,7 @@ package gitea
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -32,7 +33,17 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 }
 
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, name)
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTag(out), res, err
+}
+
+func (s *gitService) FindBranchProtection(ctx context.Context, repo, name string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branch_protections/%s", repo, name)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertBranchProtection(out), res, err
 }
 
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -42,20 +53,87 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 	return convertBranchList(out), res, err
 }
 
-func (s *gitService) ListCommits(ctx context.Context, repo string, _ scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/commits?%s", repo, encodeCommitListOptions(opts))
+	out := []*commitInfo{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
 }
 
-func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags?%s", repo, encodeListOptions(opts))
+	out := []*tag{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTagList(out), res, err
 }
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s", repo, url.PathEscape(ref))
+	out := new(commitInfo)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertChangeList(out.Files), res, err
 }
 
+// CompareChanges returns the files changed by the commits
+// between the source and target. Gitea does not return the
+// changed files of the comparison, so the changes are
+// collected from the individual commits. The compare
+// endpoint requires Gitea 1.19 or later, and older servers
+// return ErrNotSupported.
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, source, target)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if errors.Is(err, scm.ErrNotFound) {
+		return nil, res, scm.ErrNotSupported
+	}
+	return convertCompareChanges(out.Commits), res, err
+}
+
+func (s *gitService) CreateBranch(ctx context.Context, repo, name, sha string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branches", repo)
+	in := &branchInput{
+		NewBranchName: name,
+		OldRefName:    sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags", repo)
+	in := &tagInput{
+		TagName: input.Name,
+		Target:  input.Sha,
+		Message: input.Message,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTag(out), res, err
+}
+
+// UpdateBranchProtection updates the branch protection, or
+// creates it when the branch is not yet protected.
+func (s *gitService) UpdateBranchProtection(ctx context.Context, repo, name string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branch_protections/%s", repo, name)
+	in := convertBranchProtectionInput(input)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil && res != nil && res.Status == 404 {
+		path = fmt.Sprintf("api/v1/repos/%s/branch_protections", repo)
+		in.BranchName = name
+		res, err = s.client.do(ctx, "POST", path, in, out)
+	}
+	return convertBranchProtection(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 //
@@ -69,6 +147,24 @@ type (
 		Commit commit `json:"commit"`
 	}
 
+	// gitea branch creation request.
+	branchInput struct {
+		NewBranchName string `json:"new_branch_name"`
+		OldRefName    string `json:"old_ref_name"`
+	}
+
+	// gitea branch protection object.
+	branchProtection struct {
+		BranchName             string   `json:"branch_name,omitempty"`
+		EnablePush             bool     `json:"enable_push"`
+		EnablePushWhitelist    bool     `json:"enable_push_whitelist"`
+		PushWhitelistUsernames []string `json:"push_whitelist_usernames"`
+		PushWhitelistTeams     []string `json:"push_whitelist_teams"`
+		EnableStatusCheck      bool     `json:"enable_status_check"`
+		StatusCheckContexts    []string `json:"status_check_contexts"`
+		RequiredApprovals      int      `json:"required_approvals"`
+	}
+
 	// gitea commit object.
 	commit struct {
 		ID        string    `json:"id"`
@@ -82,10 +178,40 @@ type (
 
 	// gitea commit info object.
 	commitInfo struct {
-		Sha       string `json:"sha"`
-		Commit    commit `json:"commit"`
-		Author    user   `json:"author"`
-		Committer user   `json:"committer"`
+		Sha       string  `json:"sha"`
+		Commit    commit  `json:"commit"`
+		Author    user    `json:"author"`
+		Committer user    `json:"committer"`
+		Files     []*file `json:"files"`
+	}
+
+	// gitea commit comparison object.
+	compare struct {
+		TotalCommits int           `json:"total_commits"`
+		Commits      []*commitInfo `json:"commits"`
+	}
+
+	// gitea changed file object.
+	file struct {
+		Filename         string `json:"filename"`
+		PreviousFilename string `json:"previous_filename"`
+		Status           string `json:"status"`
+	}
+
+	// gitea tag object.
+	tag struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+		Commit  struct {
+			Sha string `json:"sha"`
+		} `json:"commit"`
+	}
+
+	// gitea tag creation request.
+	tagInput struct {
+		TagName string `json:"tag_name"`
+		Target  string `json:"target"`
+		Message string `json:"message,omitempty"`
 	}
 
 	// gitea signature object.
@@ -116,13 +242,60 @@ func convertBranch(src *branch) *scm.Reference {
 	}
 }
 
-// func convertCommitList(src []*commit) []*scm.Commit {
-// 	dst := []*scm.Commit{}
-// 	for _, v := range src {
-// 		dst = append(dst, convertCommitInfo(v))
-// 	}
-// 	return dst
-// }
+func convertTagList(src []*tag) []*scm.Reference {
+	dst := []*scm.Reference{}
+	for _, v := range src {
+		dst = append(dst, convertTag(v))
+	}
+	return dst
+}
+
+func convertTag(src *tag) *scm.Reference {
+	return &scm.Reference{
+		Name: scm.TrimRef(src.Name),
+		Path: scm.ExpandRef(src.Name, "refs/tags/"),
+		Sha:  src.Commit.Sha,
+	}
+}
+
+func convertBranchProtection(src *branchProtection) *scm.BranchProtection {
+	dst := &scm.BranchProtection{
+		Branch:              src.BranchName,
+		RequireStatusChecks: src.EnableStatusCheck,
+		StatusChecks:        src.StatusCheckContexts,
+		RequiredApprovals:   src.RequiredApprovals,
+		RestrictPush:        !src.EnablePush || src.EnablePushWhitelist,
+	}
+	if src.EnablePush && src.EnablePushWhitelist {
+		dst.PushUsers = src.PushWhitelistUsernames
+		dst.PushTeams = src.PushWhitelistTeams
+	}
+	return dst
+}
+
+func convertBranchProtectionInput(src *scm.BranchProtection) *branchProtection {
+	dst := &branchProtection{
+		EnablePush:          true,
+		EnableStatusCheck:   src.RequireStatusChecks,
+		StatusCheckContexts: src.StatusChecks,
+		RequiredApprovals:   src.RequiredApprovals,
+	}
+	if src.RestrictPush {
+		dst.EnablePush = len(src.PushUsers) != 0 || len(src.PushTeams) != 0
+		dst.EnablePushWhitelist = dst.EnablePush
+		dst.PushWhitelistUsernames = src.PushUsers
+		dst.PushWhitelistTeams = src.PushTeams
+	}
+	return dst
+}
+
+func convertCommitList(src []*commitInfo) []*scm.Commit {
+	dst := []*scm.Commit{}
+	for _, v := range src {
+		dst = append(dst, convertCommitInfo(v))
+	}
+	return dst
+}
 
 func convertCommitInfo(src *commitInfo) *scm.Commit {
 	return &scm.Commit{
@@ -150,3 +323,59 @@ func convertUserSignature(src user) scm.Signature {
 		Avatar: src.Avatar,
 	}
 }
+
+func convertChangeList(src []*file) []*scm.Change {
+	dst := []*scm.Change{}
+	for _, v := range src {
+		dst = append(dst, convertChange(v))
+	}
+	return dst
+}
+
+func convertChange(src *file) *scm.Change {
+	return &scm.Change{
+		Path:    src.Filename,
+		Added:   src.Status == "added",
+		Deleted: src.Status == "removed" || src.Status == "deleted",
+		Renamed: src.Status == "renamed",
+	}
+}
+
+// helper function collects the files changed by a list of
+// commits, which gitea returns newest first. A file added
+// and later removed within the commits is omitted, and a
+// file removed and later added again is modified.
+func convertCompareChanges(src []*commitInfo) []*scm.Change {
+	dst := []*scm.Change{}
+	seen := map[string]*scm.Change{}
+	for i := len(src) - 1; i >= 0; i-- {
+		for _, f := range src[i].Files {
+			change := convertChange(f)
+			prev, ok := seen[change.Path]
+			if !ok {
+				seen[change.Path] = change
+				dst = append(dst, change)
+				continue
+			}
+			switch {
+			case change.Deleted && prev.Added:
+				delete(seen, change.Path)
+				dst = removeChange(dst, prev)
+			case change.Deleted:
+				prev.Deleted = true
+			case change.Added:
+				prev.Deleted = false
+			}
+		}
+	}
+	return dst
+}
+
+func removeChange(src []*scm.Change, change *scm.Change) []*scm.Change {
+	for i, v := range src {
+		if v == change {
+			return append(src[:i], src[i+1:]...)
+		}
+	}
+	return src
+}
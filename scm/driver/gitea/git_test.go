@@ -47,22 +47,69 @@ func TestGitFindCommit(t *testing.T) {
 }
 
 func TestGitListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/commits").
+		MatchParam("sha", "master").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_list.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{Ref: "master", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commit_list.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestGitListChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/commits/f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_files.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/commit_files.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
-func TestGitCompareChanges(t *testing.T) {
+func TestGitCompareChanges_NotSupported(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/d293a2b9d6722dffde7998c953c3087e47a38a83...f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message": "Not Found"}`)
+
 	client, _ := New("https://try.gitea.io")
 	_, _, err := client.Git.CompareChanges(
 		context.Background(),
@@ -72,7 +119,39 @@ func TestGitCompareChanges(t *testing.T) {
 		scm.ListOptions{},
 	)
 	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestGitCompareChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/d293a2b9d6722dffde7998c953c3087e47a38a83...f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.CompareChanges(
+		context.Background(),
+		"go-gitea/gitea",
+		"d293a2b9d6722dffde7998c953c3087e47a38a83",
+		"f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+		scm.ListOptions{},
+	)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/compare.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -130,22 +209,244 @@ func TestGitListBranches(t *testing.T) {
 	}
 }
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branches").
+		JSON(map[string]string{
+			"new_branch_name": "release/v1.0",
+			"old_ref_name":    "c43399cad8766ee521b873a32c1652407c5a4630",
+		}).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.CreateBranch(context.Background(), "go-gitea/gitea", "release/v1.0", "c43399cad8766ee521b873a32c1652407c5a4630")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/branches/release/v1.0").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "go-gitea/gitea", "release/v1.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitFindBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.FindBranchProtection(context.Background(), "go-gitea/gitea", "master")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitUpdateBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		File("testdata/branch_protection_update.json").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.UpdateBranchProtection(context.Background(), "go-gitea/gitea", "master", &scm.BranchProtection{
+		RequireStatusChecks: true,
+		StatusChecks:        []string{"continuous-integration/travis-ci"},
+		RequiredApprovals:   2,
+		RestrictPush:        true,
+		PushUsers:           []string{"octocat"},
+		PushTeams:           []string{"justice-league"},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitUpdateBranchProtection_Create(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(404).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branch_protections").
+		JSON(map[string]interface{}{
+			"branch_name":              "master",
+			"enable_push":              false,
+			"enable_push_whitelist":    false,
+			"push_whitelist_usernames": nil,
+			"push_whitelist_teams":     nil,
+			"enable_status_check":      false,
+			"status_check_contexts":    nil,
+			"required_approvals":       0,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "go-gitea/gitea", "master", &scm.BranchProtection{
+		RestrictPush: true,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch protection created")
+	}
+}
+
 //
 // tag sub-tests
 //
 
 func TestGitFindTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.FindTag(context.Background(), "go-gitea/gitea", "v1.0.0")
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.FindTag(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestGitListTags(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/tags").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tags.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.ListTags(context.Background(), "go-gitea/gitea", scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.ListTags(context.Background(), "go-gitea/gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reference{}
+	raw, _ := ioutil.ReadFile("testdata/tags.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/tags").
+		JSON(map[string]string{
+			"tag_name": "v1.0.0",
+			"target":   "c43399cad8766ee521b873a32c1652407c5a4630",
+			"message":  "initial version",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	in := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "c43399cad8766ee521b873a32c1652407c5a4630",
+		Message: "initial version",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Git.DeleteTag(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
@@ -9,10 +9,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
-	"net/http"
+	"io/ioutil"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -33,10 +33,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitea
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
+	client.Releases = &releaseService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -60,12 +64,20 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		switch body := in.(type) {
+		case *rawBody:
+			req.Header = map[string][]string{
+				"Content-Type": {body.contentType},
+			}
+			req.Body = body.data
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header = map[string][]string{
+				"Content-Type": {"application/json"},
+			}
+			req.Body = buf
 		}
-		req.Body = buf
 	}
 
 	// execute the http request
@@ -75,12 +87,25 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// parse the gitea rate limit details, which are only
+	// returned when rate limiting is enabled.
+	res.Rate.Limit, _ = strconv.Atoi(
+		res.Header.Get("X-RateLimit-Limit"),
+	)
+	res.Rate.Remaining, _ = strconv.Atoi(
+		res.Header.Get("X-RateLimit-Remaining"),
+	)
+	res.Rate.Reset, _ = strconv.ParseInt(
+		res.Header.Get("X-RateLimit-Reset"), 10, 64,
+	)
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		return res, errors.New(
-			http.StatusText(res.Status),
-		)
+		return res, convertError(res)
 	}
 
 	if out == nil {
@@ -98,3 +123,49 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
+
+// rawBody is written to the request body as-is, without
+// json encoding. It is used to upload multipart forms.
+type rawBody struct {
+	contentType string
+	data        io.Reader
+}
+
+// gitea error object.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// gitea validation error object.
+type fieldError struct {
+	FieldNames     []string `json:"fieldNames"`
+	Classification string   `json:"classification"`
+	Message        string   `json:"message"`
+}
+
+// helper function decodes the error response and converts
+// it to the common error type. Validation errors are
+// returned as a list of field errors.
+func convertError(res *scm.Response) *scm.Error {
+	dst := &scm.Error{
+		Status: res.Status,
+		Driver: scm.DriverGitea,
+	}
+	raw, _ := ioutil.ReadAll(res.Body)
+	src := new(apiError)
+	var fields []*fieldError
+	if json.Unmarshal(raw, src) == nil {
+		dst.Message = src.Message
+	} else if json.Unmarshal(raw, &fields) == nil {
+		for _, field := range fields {
+			for _, name := range field.FieldNames {
+				dst.Fields = append(dst.Fields, scm.FieldError{
+					Field:   name,
+					Code:    field.Classification,
+					Message: field.Message,
+				})
+			}
+		}
+	}
+	return dst
+}
@@ -5,7 +5,14 @@
 // package gitea implements a Gogs client.
 package gitea
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/h2non/gock"
+)
 
 func TestClient(t *testing.T) {
 	client, err := New("https://try.gitea.io")
@@ -33,3 +40,54 @@ func TestClient_Error(t *testing.T) {
 		t.Errorf("Expect error when invalid URL")
 	}
 }
+
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1").
+		Reply(404).
+		Type("application/json").
+		File("testdata/error.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.Find(context.Background(), "go-gitea/gitea", 1)
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Expect error matches scm.ErrNotFound")
+	}
+	if got, want := err.Error(), "The target couldn't be found."; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
+func TestClient_Rate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(map[string]string{
+			"X-RateLimit-Limit":     "60",
+			"X-RateLimit-Remaining": "59",
+			"X-RateLimit-Reset":     "1512454441",
+		}).
+		File("testdata/user.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, res, err := client.Users.Find(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Rate.Remaining, 59; got != want {
+		t.Errorf("Want X-RateLimit-Remaining %d, got %d", want, got)
+	}
+	if got, want := client.Rate(), res.Rate; got != want {
+		t.Errorf("Want client rate %v, got %v", want, got)
+	}
+}
@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -42,16 +43,57 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: input.Assignees,
+		Milestone: input.Milestone,
+	}
+	if len(input.Labels) != 0 {
+		labels := &labelService{s.client}
+		found, res, err := labels.findLabels(ctx, repo, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range found {
+			in.Labels = append(in.Labels, v.ID)
+		}
 	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+// Update updates the issue. Gitea does not edit the labels
+// with the issue, so the labels are replaced first.
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	if input.Labels != nil {
+		res, err := s.SetLabels(ctx, repo, number, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	in := &issueEditInput{
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: input.Milestone,
+	}
+	if input.Assignees != nil {
+		in.Assignees = &input.Assignees
+	}
+	switch input.State {
+	case scm.IssueStateOpen:
+		in.State = "open"
+	case scm.IssueStateClosed:
+		in.State = "closed"
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
 	in := &issueCommentInput{
@@ -68,7 +110,15 @@ func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueEditInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueEditInput{State: "open"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -79,6 +129,84 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	labels := &labelService{s.client}
+	found, res, err := labels.find(ctx, repo, label)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	in := &issueLabelsInput{Labels: []int{found.ID}}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	labels := &labelService{s.client}
+	found, res, err := labels.find(ctx, repo, label)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels/%d", repo, number, found.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *issueService) SetLabels(ctx context.Context, repo string, number int, names []string) (*scm.Response, error) {
+	labels := &labelService{s.client}
+	found, res, err := labels.findLabels(ctx, repo, names)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	in := &issueLabelsInput{Labels: []int{}}
+	for _, v := range found {
+		in.Labels = append(in.Labels, v.ID)
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// AddAssignees adds the users to the issue assignees. The
+// issue is fetched first, because Gitea replaces the
+// assignee list when the issue is edited.
+func (s *issueService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &issueAssigneesInput{Assignees: []string{}}
+	for _, v := range current.Assignees {
+		in.Assignees = append(in.Assignees, userLogin(v))
+	}
+	for _, login := range logins {
+		if !containsLogin(in.Assignees, login) {
+			in.Assignees = append(in.Assignees, login)
+		}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &issueAssigneesInput{Assignees: []string{}}
+	for _, v := range current.Assignees {
+		if login := userLogin(v); !containsLogin(logins, login) {
+			in.Assignees = append(in.Assignees, login)
+		}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) find(ctx context.Context, repo string, number int) (*issue, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
 //
 // native data structures
 //
@@ -86,16 +214,18 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 type (
 	// gitea issue response object.
 	issue struct {
-		ID          int       `json:"id"`
-		Number      int       `json:"number"`
-		User        user      `json:"user"`
-		Title       string    `json:"title"`
-		Body        string    `json:"body"`
-		State       string    `json:"state"`
-		Labels      []string  `json:"labels"`
-		Comments    int       `json:"comments"`
-		Created     time.Time `json:"created_at"`
-		Updated     time.Time `json:"updated_at"`
+		ID          int        `json:"id"`
+		Number      int        `json:"number"`
+		User        user       `json:"user"`
+		Title       string     `json:"title"`
+		Body        string     `json:"body"`
+		State       string     `json:"state"`
+		Labels      []*label   `json:"labels"`
+		Assignees   []*user    `json:"assignees"`
+		Milestone   *milestone `json:"milestone"`
+		Comments    int        `json:"comments"`
+		Created     time.Time  `json:"created_at"`
+		Updated     time.Time  `json:"updated_at"`
 		PullRequest *struct {
 			Merged   bool        `json:"merged"`
 			MergedAt interface{} `json:"merged_at"`
@@ -104,8 +234,25 @@ type (
 
 	// gitea issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string   `json:"title"`
+		Body      string   `json:"body"`
+		Labels    []int    `json:"labels,omitempty"`
+		Assignees []string `json:"assignees,omitempty"`
+		Milestone *int     `json:"milestone,omitempty"`
+	}
+
+	// gitea issue edit request object.
+	issueEditInput struct {
+		Title     string    `json:"title,omitempty"`
+		Body      string    `json:"body,omitempty"`
+		State     string    `json:"state,omitempty"`
+		Assignees *[]string `json:"assignees,omitempty"`
+		Milestone *int      `json:"milestone,omitempty"`
+	}
+
+	// gitea issue assignees request object.
+	issueAssigneesInput struct {
+		Assignees []string `json:"assignees"`
 	}
 
 	// gitea issue comment response object.
@@ -138,14 +285,17 @@ func convertIssueList(from []*issue) []*scm.Issue {
 
 func convertIssue(from *issue) *scm.Issue {
 	return &scm.Issue{
-		Number:  from.Number,
-		Title:   from.Title,
-		Body:    from.Body,
-		Link:    "", // TODO construct the link to the issue.
-		Closed:  from.State == "closed",
-		Author:  *convertUser(&from.User),
-		Created: from.Created,
-		Updated: from.Updated,
+		Number:    from.Number,
+		Title:     from.Title,
+		Body:      from.Body,
+		Link:      "", // TODO construct the link to the issue.
+		Labels:    convertLabels(from.Labels),
+		Closed:    from.State == "closed",
+		Author:    *convertUser(&from.User),
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.Created,
+		Updated:   from.Updated,
 	}
 }
 
@@ -166,3 +316,14 @@ func convertIssueComment(from *issueComment) *scm.Comment {
 		Updated: from.UpdatedAt,
 	}
 }
+
+// helper function returns true if the login is in the
+// list. Gitea logins are case insensitive.
+func containsLogin(logins []string, login string) bool {
+	for _, v := range logins {
+		if strings.EqualFold(v, login) {
+			return true
+		}
+	}
+	return false
+}
@@ -74,6 +74,10 @@ func TestIssueCreate(t *testing.T) {
 
 	gock.New("https://try.gitea.io").
 		Post("/api/v1/repos/go-gitea/gitea/issues").
+		JSON(map[string]string{
+			"title": "Bug found",
+			"body":  "I'm having a problem with this.",
+		}).
 		Reply(200).
 		Type("application/json").
 		File("testdata/issue.json")
@@ -99,11 +103,167 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCreate_Labels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"body":      "I'm having a problem with this.",
+			"labels":    []int{1},
+			"assignees": []string{"janedoe"},
+			"milestone": 1,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	milestone := 1
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Body:      "I'm having a problem with this.",
+		Labels:    []string{"bug"},
+		Assignees: []string{"janedoe"},
+		Milestone: &milestone,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.Create(context.Background(), "go-gitea/gitea", &input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string][]int{"labels": {1}}).
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"state":     "closed",
+			"milestone": 1,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	milestone := 1
+	input := &scm.IssueInput{
+		Title:     "Bug found",
+		State:     scm.IssueStateClosed,
+		Labels:    []string{"bug"},
+		Milestone: &milestone,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.Update(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueUpdate_ClearAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string][]string{"assignees": {}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := &scm.IssueInput{
+		Assignees: []string{},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.Update(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
@@ -123,6 +283,139 @@ func TestIssueUnlock(t *testing.T) {
 	}
 }
 
+func TestIssueAddAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string][]string{"assignees": {"janedoe", "jcitizen"}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.AddAssignees(context.Background(), "go-gitea/gitea", 1, []string{"JaneDoe", "jcitizen"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueRemoveAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string][]string{"assignees": {}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.RemoveAssignees(context.Background(), "go-gitea/gitea", 1, []string{"janedoe"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueAddLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string][]int{"labels": {2}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.AddLabel(context.Background(), "go-gitea/gitea", 1, "enhancement")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/1/labels/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.RemoveLabel(context.Background(), "go-gitea/gitea", 1, "bug")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueSetLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string][]int{"labels": {2, 1}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.SetLabels(context.Background(), "go-gitea/gitea", 1, []string{"enhancement", "bug"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 //
 // issue comment sub-tests
 //
@@ -0,0 +1,180 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// labelService implements the label service. Gitea
+// identifies labels by id, so labels are looked up by name
+// in the repository label list, fetching one page at a time
+// until every name is found.
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels?%s", repo, encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertFromColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertFromColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	labels, res, err := s.findLabels(ctx, repo, []string{name})
+	if err != nil {
+		return nil, res, err
+	}
+	return labels[0], res, nil
+}
+
+// helper function returns the repository labels with the
+// names, in the same order. The label pages are fetched until
+// all names are found or there are no more pages.
+func (s *labelService) findLabels(ctx context.Context, repo string, names []string) ([]*label, *scm.Response, error) {
+	found := map[string]*label{}
+	opts := scm.ListOptions{Page: 1, Size: 50}
+	for {
+		path := fmt.Sprintf("api/v1/repos/%s/labels?%s", repo, encodeListOptions(opts))
+		out := []*label{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			if _, ok := found[v.Name]; !ok {
+				found[v.Name] = v
+			}
+		}
+		var labels []*label
+		for _, name := range names {
+			if v, ok := found[name]; ok {
+				labels = append(labels, v)
+			}
+		}
+		if len(labels) == len(names) {
+			return labels, res, nil
+		}
+		if res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea label response object.
+	label struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Color       string `json:"color"`
+		Description string `json:"description"`
+	}
+
+	// gitea label request object.
+	labelInput struct {
+		Name        string `json:"name,omitempty"`
+		Color       string `json:"color,omitempty"`
+		Description string `json:"description,omitempty"`
+	}
+
+	// gitea issue labels request object.
+	issueLabelsInput struct {
+		Labels []int `json:"labels"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertLabelList(src []*label) []*scm.Label {
+	dst := []*scm.Label{}
+	for _, v := range src {
+		dst = append(dst, convertLabel(v))
+	}
+	return dst
+}
+
+func convertLabel(src *label) *scm.Label {
+	return &scm.Label{
+		Name:        src.Name,
+		Color:       strings.TrimPrefix(src.Color, "#"),
+		Description: src.Description,
+	}
+}
+
+// helper function returns the labels of an issue or pull
+// request, or nil if there are no labels.
+func convertLabels(src []*label) []scm.Label {
+	var dst []scm.Label
+	for _, v := range src {
+		dst = append(dst, *convertLabel(v))
+	}
+	return dst
+}
+
+// helper function returns the label color with the leading
+// hash, which older Gitea versions require.
+func convertFromColor(color string) string {
+	if color == "" || strings.HasPrefix(color, "#") {
+		return color
+	}
+	return "#" + color
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+type milestoneService struct {
+	client *wrapper
+}
+
+func (s *milestoneService) Find(ctx context.Context, repo string, id int) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/milestones?%s", repo, encodeMilestoneListOptions(opts))
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMilestoneList(out), res, err
+}
+
+func (s *milestoneService) Create(ctx context.Context, repo string, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/milestones", repo)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "POST", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Update(ctx context.Context, repo string, id int, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "PATCH", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Close(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	in := &milestoneInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea milestone response object.
+	milestone struct {
+		ID          int       `json:"id"`
+		Title       string    `json:"title"`
+		Description string    `json:"description"`
+		State       string    `json:"state"`
+		DueOn       null.Time `json:"due_on"`
+	}
+
+	// gitea milestone request object.
+	milestoneInput struct {
+		Title       string     `json:"title,omitempty"`
+		Description string     `json:"description,omitempty"`
+		State       string     `json:"state,omitempty"`
+		DueOn       *time.Time `json:"due_on,omitempty"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertMilestoneInput(src *scm.MilestoneInput) *milestoneInput {
+	dst := &milestoneInput{
+		Title:       src.Title,
+		Description: src.Description,
+	}
+	if !src.DueDate.IsZero() {
+		dst.DueOn = &src.DueDate
+	}
+	return dst
+}
+
+func convertMilestoneList(src []*milestone) []*scm.Milestone {
+	dst := []*scm.Milestone{}
+	for _, v := range src {
+		dst = append(dst, convertMilestone(v))
+	}
+	return dst
+}
+
+// helper function returns the common milestone structure,
+// or nil if the issue or pull request has no milestone.
+func convertMilestone(src *milestone) *scm.Milestone {
+	if src == nil {
+		return nil
+	}
+	return &scm.Milestone{
+		ID:          src.ID,
+		Title:       src.Title,
+		Description: src.Description,
+		Closed:      src.State == "closed",
+		DueDate:     src.DueOn.ValueOrZero(),
+	}
+}
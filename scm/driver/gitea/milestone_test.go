@@ -0,0 +1,165 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestMilestoneFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/milestones/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestone.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Milestones.Find(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Milestone)
+	raw, _ := ioutil.ReadFile("testdata/milestone.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/milestones").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		MatchParam("state", "all").
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestones.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Milestones.List(context.Background(), "go-gitea/gitea", scm.MilestoneListOptions{Page: 1, Size: 30, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Milestone{}
+	raw, _ := ioutil.ReadFile("testdata/milestones.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/milestones").
+		JSON(map[string]string{
+			"title":       "v1.0",
+			"description": "First stable release",
+			"due_on":      "2020-04-01T00:00:00Z",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/milestone.json")
+
+	input := &scm.MilestoneInput{
+		Title:       "v1.0",
+		Description: "First stable release",
+		DueDate:     time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Milestones.Create(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Milestone)
+	raw, _ := ioutil.ReadFile("testdata/milestone.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/milestones/1").
+		JSON(map[string]string{
+			"title": "v1.0",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestone.json")
+
+	input := &scm.MilestoneInput{
+		Title: "v1.0",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Milestones.Update(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Milestone)
+	raw, _ := ioutil.ReadFile("testdata/milestone.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/milestones/1").
+		JSON(map[string]string{
+			"state": "closed",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestone.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Milestones.Close(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
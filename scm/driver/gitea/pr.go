@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -23,8 +24,11 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
@@ -34,18 +38,31 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), res, err
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments?%s", repo, index, encodeListOptions(opts))
+	out := []*issueComment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueCommentList(out), res, err
 }
 
-func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListChanges(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/files?%s", repo, index, encodeListOptions(opts))
+	out := []*file{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertChangeList(out), res, err
 }
 
+// Create creates the pull request. Gitea marks pull requests
+// as work in progress by the title, so the title is prefixed
+// with WIP: when creating a draft.
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	title := input.Title
+	if input.Draft && !isDraftTitle(title) {
+		title = "WIP: " + title
+	}
 	path := fmt.Sprintf("api/v1/repos/%s/pulls", repo)
 	in := &prInput{
-		Title: input.Title,
+		Title: title,
 		Body:  input.Body,
 		Head:  input.Source,
 		Base:  input.Target,
@@ -55,22 +72,113 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) Update(ctx context.Context, repo string, index int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prUpdateInput{
+		Title: input.Title,
+		Body:  input.Body,
+		Base:  input.Target,
+	}
+	switch input.State {
+	case scm.PullRequestStateOpen:
+		in.State = "open"
+	case scm.PullRequestStateClosed:
+		in.State = "closed"
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertIssueComment(out), res, err
 }
 
-func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.Response, error) {
+func (s *pullService) DeleteComment(ctx context.Context, repo string, index, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *pullService) Merge(ctx context.Context, repo string, index int, input *scm.MergeInput) (*scm.Response, error) {
+	if input == nil {
+		input = new(scm.MergeInput)
+	}
 	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, index)
-	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	in := &prMergeInput{
+		Do:                     convertFromMergeMethod(input.Method),
+		MergeTitleField:        input.Title,
+		MergeMessageField:      input.Message,
+		HeadCommitID:           input.Sha,
+		DeleteBranchAfterMerge: input.DeleteSourceBranch,
+		MergeWhenChecksSucceed: input.MergeWhenPipelineSucceeds,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
 	return res, err
 }
 
-func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) Close(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prStateInput{
+		State: "closed",
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *pullService) Reopen(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prStateInput{
+		State: "open",
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, index int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, index)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, index int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, index)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+func (s *pullService) AddLabel(ctx context.Context, repo string, index int, label string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabel(ctx, repo, index, label)
+}
+
+func (s *pullService) RemoveLabel(ctx context.Context, repo string, index int, label string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, index, label)
+}
+
+func (s *pullService) SetLabels(ctx context.Context, repo string, index int, labels []string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.SetLabels(ctx, repo, index, labels)
+}
+
+func (s *pullService) AddAssignees(ctx context.Context, repo string, index int, logins []string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddAssignees(ctx, repo, index, logins)
+}
+
+func (s *pullService) RemoveAssignees(ctx context.Context, repo string, index int, logins []string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveAssignees(ctx, repo, index, logins)
 }
 
 //
@@ -78,28 +186,29 @@ func (s *pullService) Close(context.Context, string, int) (*scm.Response, error)
 //
 
 type pr struct {
-	ID         int        `json:"id"`
-	Number     int        `json:"number"`
-	User       user       `json:"user"`
-	Title      string     `json:"title"`
-	Body       string     `json:"body"`
-	State      string     `json:"state"`
-	HeadBranch string     `json:"head_branch"`
-	HeadRepo   repository `json:"head_repo"`
-	Head       reference  `json:"head"`
-	BaseBranch string     `json:"base_branch"`
-	BaseRepo   repository `json:"base_repo"`
-	Base       reference  `json:"base"`
-	HTMLURL    string     `json:"html_url"`
-	DiffURL    string     `json:"diff_url"`
-	Mergeable  bool       `json:"mergeable"`
-	Merged     bool       `json:"merged"`
-	Created    time.Time  `json:"created_at"`
-	Updated    time.Time  `json:"updated_at"`
-	Labels     []struct {
-		Name  string `json:"name"`
-		Color string `json:"color"`
-	} `json:"labels"`
+	ID                 int        `json:"id"`
+	Number             int        `json:"number"`
+	User               user       `json:"user"`
+	Title              string     `json:"title"`
+	Body               string     `json:"body"`
+	State              string     `json:"state"`
+	Draft              bool       `json:"draft"`
+	HeadBranch         string     `json:"head_branch"`
+	HeadRepo           repository `json:"head_repo"`
+	Head               reference  `json:"head"`
+	BaseBranch         string     `json:"base_branch"`
+	BaseRepo           repository `json:"base_repo"`
+	Base               reference  `json:"base"`
+	HTMLURL            string     `json:"html_url"`
+	DiffURL            string     `json:"diff_url"`
+	Mergeable          bool       `json:"mergeable"`
+	Merged             bool       `json:"merged"`
+	Created            time.Time  `json:"created_at"`
+	Updated            time.Time  `json:"updated_at"`
+	Labels             []*label   `json:"labels"`
+	Assignees          []*user    `json:"assignees"`
+	RequestedReviewers []*user    `json:"requested_reviewers"`
+	Milestone          *milestone `json:"milestone"`
 }
 
 type reference struct {
@@ -115,6 +224,31 @@ type prInput struct {
 	Base  string `json:"base"`
 }
 
+type prUpdateInput struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Base  string `json:"base,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+type prReviewersInput struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+type prStateInput struct {
+	State string `json:"state"`
+}
+
+type prMergeInput struct {
+	Do                     string `json:"Do"`
+	MergeTitleField        string `json:"MergeTitleField,omitempty"`
+	MergeMessageField      string `json:"MergeMessageField,omitempty"`
+	HeadCommitID           string `json:"head_commit_id,omitempty"`
+	DeleteBranchAfterMerge bool   `json:"delete_branch_after_merge,omitempty"`
+	MergeWhenChecksSucceed bool   `json:"merge_when_checks_succeed,omitempty"`
+}
+
 //
 // native data structure conversion
 //
@@ -128,33 +262,54 @@ func convertPullRequests(src []*pr) []*scm.PullRequest {
 }
 
 func convertPullRequest(src *pr) *scm.PullRequest {
-	var labels []scm.Label
-	for _, label := range src.Labels {
-		labels = append(labels, scm.Label{
-			Name:  label.Name,
-			Color: label.Color,
-		})
-	}
 	return &scm.PullRequest{
-		Number:  src.Number,
-		Title:   src.Title,
-		Body:    src.Body,
-		Sha:     src.Head.Sha,
-		Source:  src.Head.Name,
-		Target:  src.Base.Name,
-		Link:    src.HTMLURL,
-		Diff:    src.DiffURL,
-		Fork:    src.Base.Repo.FullName,
-		Ref:     fmt.Sprintf("refs/pull/%d/head", src.Number),
-		Closed:  src.State == "closed",
-		Author:  *convertUser(&src.User),
-		Merged:  src.Merged,
-		Created: src.Created,
-		Updated: src.Updated,
-		Labels:  labels,
+		Number:    src.Number,
+		Title:     src.Title,
+		Body:      src.Body,
+		Sha:       src.Head.Sha,
+		Source:    src.Head.Name,
+		Target:    src.Base.Name,
+		Link:      src.HTMLURL,
+		Diff:      src.DiffURL,
+		Fork:      src.Base.Repo.FullName,
+		Ref:       fmt.Sprintf("refs/pull/%d/head", src.Number),
+		Closed:    src.State == "closed",
+		Author:    *convertUser(&src.User),
+		Merged:    src.Merged,
+		Draft:     src.Draft || isDraftTitle(src.Title),
+		Created:   src.Created,
+		Updated:   src.Updated,
+		Labels:    convertLabels(src.Labels),
+		Reviewers: convertUserList(src.RequestedReviewers),
+		Assignees: convertUserList(src.Assignees),
+		Milestone: convertMilestone(src.Milestone),
 	}
 }
 
+// helper function returns the Gitea merge style. The
+// repository default is not exposed, so the default method
+// creates a merge commit.
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	case scm.MergeMethodFastForward:
+		return "fast-forward-only"
+	default:
+		return "merge"
+	}
+}
+
+// helper function returns true if the title marks the pull
+// request as work in progress, using the default Gitea
+// WIP: and [WIP] prefixes.
+func isDraftTitle(title string) bool {
+	title = strings.ToUpper(title)
+	return strings.HasPrefix(title, "WIP:") || strings.HasPrefix(title, "[WIP]")
+}
+
 func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 	return &scm.PullRequest{
 		Number:  src.Number,
@@ -102,10 +102,105 @@ func TestPullRequestCreate(t *testing.T) {
 }
 
 func TestPullRequestClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/pulls/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
 	client, _ := New("https://try.gitea.io")
 	_, err := client.PullRequests.Close(context.Background(), "go-gitea/gitea", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullRequestReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/pulls/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullRequestUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		JSON(map[string]string{
+			"title": "WIP: Add License File",
+			"base":  "master",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_update.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Title:  "WIP: Add License File",
+		Target: "master",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.Update(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr_update.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestCreate_Draft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls").
+		JSON(map[string]string{
+			"title": "WIP: Add License File",
+			"body":  "Using a BSD License",
+			"head":  "feature",
+			"base":  "master",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_update.json")
+
+	input := &scm.PullRequestInput{
+		Title:  "Add License File",
+		Body:   "Using a BSD License",
+		Source: "feature",
+		Target: "master",
+		Draft:  true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.Create(context.Background(), "jcitizen/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Draft {
+		t.Errorf("Want draft pull request")
 	}
 }
 
@@ -113,12 +208,45 @@ func TestPullRequestMerge(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://try.gitea.io").
-		Post("/api/v1/repos/go-gitea/gitea/pulls/1").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		JSON(map[string]string{"Do": "merge"}).
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.Merge(context.Background(), "go-gitea/gitea", 1, nil)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullRequestMerge_Options(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		JSON(map[string]interface{}{
+			"Do":                        "squash",
+			"MergeTitleField":           "Add feature",
+			"MergeMessageField":         "Details",
+			"head_commit_id":            "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"delete_branch_after_merge": true,
+			"merge_when_checks_succeed": true,
+		}).
 		Reply(204).
 		Type("application/json")
 
+	input := &scm.MergeInput{
+		Method:                    scm.MergeMethodSquash,
+		Title:                     "Add feature",
+		Message:                   "Details",
+		Sha:                       "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		DeleteSourceBranch:        true,
+		MergeWhenPipelineSucceeds: true,
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.PullRequests.Merge(context.Background(), "go-gitea/gitea", 1)
+	_, err := client.PullRequests.Merge(context.Background(), "go-gitea/gitea", 1, input)
 	if err != nil {
 		t.Error(err)
 	}
@@ -128,11 +256,83 @@ func TestPullRequestMerge(t *testing.T) {
 // pull request change sub-tests
 //
 
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers":      {"janedoe"},
+			"team_reviewers": {"owners"},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"janedoe"},
+		Teams: []string{"owners"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestPullRequestRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/pulls/1/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers": {"janedoe"},
+		}).
+		Reply(204).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"janedoe"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestPullRequestChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1/files").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_files.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.PullRequests.ListChanges(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.PullRequests.ListChanges(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/pr_files.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -141,33 +341,98 @@ func TestPullRequestChanges(t *testing.T) {
 //
 
 func TestPullRequestCommentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/comments/74").
+		Reply(200).
+		Type("application/json").
+		File("testdata/comment.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.PullRequests.FindComment(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.PullRequests.FindComment(context.Background(), "go-gitea/gitea", 1, 74)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestPullRequestCommentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/comments.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.PullRequests.ListComments(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.PullRequests.ListComments(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestPullRequestCommentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/1/comments").
+		JSON(map[string]string{"body": "what?"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/comment.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.PullRequests.CreateComment(context.Background(), "go-gitea/gitea", 1, &scm.CommentInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.PullRequests.CreateComment(context.Background(), "go-gitea/gitea", 1, &scm.CommentInput{Body: "what?"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestPullRequestCommentDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/comments/74").
+		Reply(204)
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.PullRequests.DeleteComment(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.PullRequests.DeleteComment(context.Background(), "go-gitea/gitea", 1, 74)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
@@ -0,0 +1,186 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type releaseService struct {
+	client *wrapper
+}
+
+func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/%d", repo, id)
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) FindByTag(ctx context.Context, repo, tag string) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/tags/%s", repo, url.PathEscape(tag))
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases?%s", repo, encodeListOptions(opts))
+	out := []*release{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseList(out), res, err
+}
+
+func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases", repo)
+	in := convertReleaseInput(input)
+	out := new(release)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/%d", repo, id)
+	in := convertReleaseInput(input)
+	out := new(release)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) UpdateByTag(ctx context.Context, repo, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.Update(ctx, repo, rel.ID, input)
+}
+
+func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *releaseService) DeleteByTag(ctx context.Context, repo, tag string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/tags/%s", repo, url.PathEscape(tag))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, id int, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases/%d/assets?name=%s", repo, id, url.QueryEscape(input.Name))
+	buf := new(bytes.Buffer)
+	form := multipart.NewWriter(buf)
+	part, err := form.CreateFormFile("attachment", input.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(part, input.Data); err != nil {
+		return nil, nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, nil, err
+	}
+	in := &rawBody{
+		contentType: form.FormDataContentType(),
+		data:        buf,
+	}
+	out := new(attachment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAttachment(out), res, err
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea release object.
+	release struct {
+		ID          int       `json:"id"`
+		Title       string    `json:"name"`
+		Description string    `json:"body"`
+		Link        string    `json:"html_url"`
+		Tag         string    `json:"tag_name"`
+		Commitish   string    `json:"target_commitish"`
+		Draft       bool      `json:"draft"`
+		Prerelease  bool      `json:"prerelease"`
+		Created     time.Time `json:"created_at"`
+		Published   time.Time `json:"published_at"`
+	}
+
+	// gitea release request object.
+	releaseInput struct {
+		Title       string `json:"name,omitempty"`
+		Description string `json:"body,omitempty"`
+		Tag         string `json:"tag_name,omitempty"`
+		Commitish   string `json:"target_commitish,omitempty"`
+		Draft       *bool  `json:"draft,omitempty"`
+		Prerelease  *bool  `json:"prerelease,omitempty"`
+	}
+
+	// gitea release attachment object.
+	attachment struct {
+		ID      int       `json:"id"`
+		Name    string    `json:"name"`
+		Size    int64     `json:"size"`
+		Link    string    `json:"browser_download_url"`
+		Created time.Time `json:"created_at"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertReleaseInput(src *scm.ReleaseInput) *releaseInput {
+	return &releaseInput{
+		Title:       src.Title,
+		Description: src.Description,
+		Tag:         src.Tag,
+		Commitish:   src.Commitish,
+		Draft:       src.Draft,
+		Prerelease:  src.Prerelease,
+	}
+}
+
+func convertReleaseList(src []*release) []*scm.Release {
+	dst := []*scm.Release{}
+	for _, v := range src {
+		dst = append(dst, convertRelease(v))
+	}
+	return dst
+}
+
+func convertRelease(src *release) *scm.Release {
+	return &scm.Release{
+		ID:          src.ID,
+		Title:       src.Title,
+		Description: src.Description,
+		Link:        src.Link,
+		Tag:         src.Tag,
+		Commitish:   src.Commitish,
+		Draft:       src.Draft,
+		Prerelease:  src.Prerelease,
+		Created:     src.Created,
+		Published:   src.Published,
+	}
+}
+
+func convertAttachment(src *attachment) *scm.ReleaseAsset {
+	return &scm.ReleaseAsset{
+		ID:      src.ID,
+		Name:    src.Name,
+		Link:    src.Link,
+		Size:    src.Size,
+		Created: src.Created,
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.Find(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.FindByTag(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/releases.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.List(context.Background(), "go-gitea/gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/releases").
+		File("testdata/release_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "master",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.Create(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/releases/1").
+		File("testdata/release_create.json").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "master",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.Update(context.Background(), "go-gitea/gitea", 1, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseUpdate_Partial(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/releases/1").
+		JSON(map[string]interface{}{
+			"body":  "Updated description",
+			"draft": false,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	draft := false
+	in := &scm.ReleaseInput{
+		Description: "Updated description",
+		Draft:       &draft,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Releases.Update(context.Background(), "go-gitea/gitea", 1, in)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestReleaseDeleteByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Releases.DeleteByTag(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/releases/1/assets").
+		MatchParam("name", "example.txt").
+		MatchHeader("Content-Type", "multipart/form-data").
+		Reply(201).
+		Type("application/json").
+		File("testdata/attachment.json")
+
+	in := &scm.ReleaseAssetInput{
+		Name: "example.txt",
+		Data: strings.NewReader("hello world"),
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.UploadAsset(context.Background(), "go-gitea/gitea", 1, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/attachment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
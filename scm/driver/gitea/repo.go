@@ -32,6 +32,13 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+func (s *repositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s", repo)
 	out := new(repository)
@@ -53,6 +60,13 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm
 	return convertHookList(out), res, err
 }
 
+func (s *repositoryService) ListKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys?%s", repo, encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
 func (s *repositoryService) ListStatus(ctx context.Context, repo string, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/statuses/%s?%s", repo, ref, encodeListOptions(opts))
 	out := []*status{}
@@ -85,6 +99,18 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+func (s *repositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	in := &keyInput{
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/statuses/%s", repo, ref)
 	in := &statusInput{
@@ -128,6 +154,11 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) DeleteKey(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -173,6 +204,22 @@ type (
 		Secret      string `json:"secret"`
 	}
 
+	// gitea deploy key resource.
+	key struct {
+		ID       int       `json:"id"`
+		Title    string    `json:"title"`
+		Key      string    `json:"key"`
+		ReadOnly bool      `json:"read_only"`
+		Created  time.Time `json:"created_at"`
+	}
+
+	// gitea deploy key creation request.
+	keyInput struct {
+		Title    string `json:"title"`
+		Key      string `json:"key"`
+		ReadOnly bool   `json:"read_only"`
+	}
+
 	// gitea status resource.
 	status struct {
 		CreatedAt   time.Time `json:"created_at"`
@@ -243,6 +290,24 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(src []*key) []*scm.DeployKey {
+	var dst []*scm.DeployKey
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: from.ReadOnly,
+		Created:  from.Created,
+	}
+}
+
 func convertHookEvent(from scm.HookEvents) []string {
 	var events []string
 	if from.PullRequest {
@@ -340,3 +340,103 @@ func TestStatusCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestRepositoryKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.FindKey(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.ListKeys(context.Background(), "go-gitea/gitea", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/keys").
+		File("testdata/key_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	in := &scm.DeployKeyInput{
+		Title:    "deploy",
+		Key:      "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.CreateKey(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.DeleteKey(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
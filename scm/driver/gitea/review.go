@@ -6,6 +6,8 @@ package gitea
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -29,3 +31,135 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListSummaries(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*pullReview{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPullReviewList(out), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSummaryInput) (*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := &pullReviewInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Event:    convertFromReviewState(input.State),
+		Comments: []*pullReviewCommentInput{},
+	}
+	for _, c := range input.Comments {
+		comment := &pullReviewCommentInput{
+			Path: c.Path,
+			Body: c.Body,
+		}
+		if c.Side == scm.ReviewSideOld {
+			comment.OldPosition = c.Line
+		} else {
+			comment.NewPosition = c.Line
+		}
+		in.Comments = append(in.Comments, comment)
+	}
+	out := new(pullReview)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPullReview(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &pullReviewDismissInput{
+		Message: message,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *reviewService) FindApprovals(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+//
+// native data structures
+//
+
+type pullReview struct {
+	ID          int       `json:"id"`
+	User        user      `json:"user"`
+	Body        string    `json:"body"`
+	CommitID    string    `json:"commit_id"`
+	State       string    `json:"state"`
+	Dismissed   bool      `json:"dismissed"`
+	HTMLURL     string    `json:"html_url"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type pullReviewInput struct {
+	Body     string                    `json:"body,omitempty"`
+	CommitID string                    `json:"commit_id,omitempty"`
+	Event    string                    `json:"event,omitempty"`
+	Comments []*pullReviewCommentInput `json:"comments"`
+}
+
+type pullReviewCommentInput struct {
+	Path        string `json:"path"`
+	Body        string `json:"body"`
+	OldPosition int    `json:"old_position,omitempty"`
+	NewPosition int    `json:"new_position,omitempty"`
+}
+
+type pullReviewDismissInput struct {
+	Message string `json:"message"`
+}
+
+//
+// native data structure conversion
+//
+
+func convertPullReviewList(src []*pullReview) []*scm.ReviewSummary {
+	dst := []*scm.ReviewSummary{}
+	for _, v := range src {
+		dst = append(dst, convertPullReview(v))
+	}
+	return dst
+}
+
+func convertPullReview(src *pullReview) *scm.ReviewSummary {
+	return &scm.ReviewSummary{
+		ID:      src.ID,
+		State:   convertReviewState(src),
+		Body:    src.Body,
+		Sha:     src.CommitID,
+		Link:    src.HTMLURL,
+		Author:  *convertUser(&src.User),
+		Created: src.SubmittedAt,
+	}
+}
+
+func convertReviewState(src *pullReview) scm.ReviewState {
+	if src.Dismissed {
+		return scm.ReviewStateDismissed
+	}
+	switch src.State {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "REQUEST_CHANGES":
+		return scm.ReviewStateChangesRequested
+	case "COMMENT":
+		return scm.ReviewStateCommented
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// helper function returns the review event for the state.
+// An empty event creates a pending review.
+func convertFromReviewState(src scm.ReviewState) string {
+	switch src {
+	case scm.ReviewStateApproved:
+		return "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	case scm.ReviewStateCommented:
+		return "COMMENT"
+	default:
+		return ""
+	}
+}
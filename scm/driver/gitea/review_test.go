@@ -6,9 +6,13 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -42,3 +46,101 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListSummaries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviews.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.ListSummaries(context.Background(), "jcitizen/my-repo", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		JSON(map[string]interface{}{
+			"body":      "Looks good to me.",
+			"commit_id": "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"event":     "APPROVED",
+			"comments": []map[string]interface{}{
+				{"path": "README.md", "body": "Nice", "new_position": 2},
+				{"path": "README.md", "body": "Why remove this?", "old_position": 4},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateApproved,
+		Body:  "Looks good to me.",
+		Sha:   "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		Comments: []*scm.ReviewInput{
+			{Path: "README.md", Body: "Nice", Line: 2},
+			{Path: "README.md", Body: "Why remove this?", Line: 4, Side: scm.ReviewSideOld},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.Submit(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewSummary)
+	raw, _ := ioutil.ReadFile("testdata/pr_review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/13/dismissals").
+		JSON(map[string]string{"message": "outdated"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Reviews.Dismiss(context.Background(), "jcitizen/my-repo", 1, 13, "outdated")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewFindApprovals(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Reviews.FindApprovals(context.Background(), "go-gitea/gitea", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -59,6 +59,16 @@ func convertUser(src *user) *scm.User {
 	}
 }
 
+// helper function returns the users in the list, or nil
+// if the list is empty.
+func convertUserList(src []*user) []scm.User {
+	var dst []scm.User
+	for _, v := range src {
+		dst = append(dst, *convertUser(v))
+	}
+	return dst
+}
+
 func userLogin(src *user) string {
 	if src.Username != "" {
 		return src.Username
@@ -22,6 +22,19 @@ func Test_encodeListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Page: 10,
+		Size: 30,
+		Ref:  "master",
+	}
+	want := "limit=30&page=10&sha=master"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -79,3 +92,16 @@ func Test_encodePullRequestListOptions_Closed(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodeMilestoneListOptions(t *testing.T) {
+	opts := scm.MilestoneListOptions{
+		Page:   10,
+		Size:   30,
+		Closed: true,
+	}
+	want := "limit=30&page=10&state=closed"
+	got := encodeMilestoneListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded milestone list options %q, got %q", want, got)
+	}
+}
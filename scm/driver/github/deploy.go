@@ -0,0 +1,123 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+type deployment struct {
+	ID          int64       `json:"id"`
+	Sha         string      `json:"sha"`
+	Ref         string      `json:"ref"`
+	Task        string      `json:"task"`
+	Environment string      `json:"environment"`
+	Description string      `json:"description"`
+	Payload     interface{} `json:"payload"`
+	Creator     user        `json:"creator"`
+	Created     time.Time   `json:"created_at"`
+	Updated     time.Time   `json:"updated_at"`
+}
+
+type deploymentInput struct {
+	Ref         string      `json:"ref"`
+	Task        string      `json:"task,omitempty"`
+	Environment string      `json:"environment,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Payload     interface{} `json:"payload,omitempty"`
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo string, id int64) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d", repo, id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments?%s", repo, encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments", repo)
+	in := &deploymentInput{
+		Ref:         input.Ref,
+		Task:        input.Task,
+		Environment: input.Environment,
+		Description: input.Desc,
+		Payload:     input.Payload,
+	}
+	// the github api accepts a branch, tag or commit sha
+	// as the deployment reference.
+	if in.Ref == "" {
+		in.Ref = input.Sha
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo string, id int64, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses", repo, id)
+	in := &deployStatus{
+		State:          convertFromState(input.State),
+		Environment:    input.Environment,
+		EnvironmentURL: input.EnvironmentURL,
+		TargetURL:      input.Target,
+		Description:    input.Desc,
+	}
+	out := new(deployStatus)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+func (s *deploymentService) ListStatuses(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses?%s", repo, id, encodeListOptions(opts))
+	out := []*deployStatus{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeployStatusList(out), res, err
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	return &scm.Deployment{
+		Number:      from.ID,
+		Ref:         from.Ref,
+		Sha:         from.Sha,
+		Task:        from.Task,
+		Environment: from.Environment,
+		Desc:        from.Description,
+		Payload:     from.Payload,
+		Author:      *convertUser(&from.Creator),
+		Created:     from.Created,
+		Updated:     from.Updated,
+	}
+}
+
+func convertDeployStatusList(from []*deployStatus) []*scm.DeployStatus {
+	to := []*scm.DeployStatus{}
+	for _, v := range from {
+		to = append(to, convertDeployStatus(v))
+	}
+	return to
+}
@@ -34,6 +34,13 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) FindBranchProtection(ctx context.Context, repo, name string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, name)
+	out := new(protection)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertProtection(name, out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches?%s", repo, encodeListOptions(opts))
 	out := []*branch{}
@@ -69,6 +76,61 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo, name, sha string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs", repo)
+	in := &refInput{
+		Ref: scm.ExpandRef(name, "refs/heads"),
+		Sha: sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Reference, *scm.Response, error) {
+	in := &refInput{
+		Ref: scm.ExpandRef(input.Name, "refs/tags"),
+		Sha: input.Sha,
+	}
+	// an annotated tag requires creating the tag object
+	// before creating the reference that points to it.
+	if input.Message != "" {
+		tag := &tagInput{
+			Tag:     input.Name,
+			Message: input.Message,
+			Object:  input.Sha,
+			Type:    "commit",
+		}
+		out := new(tagObject)
+		path := fmt.Sprintf("repos/%s/git/tags", repo)
+		res, err := s.client.do(ctx, "POST", path, tag, out)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Sha = out.Sha
+	}
+	path := fmt.Sprintf("repos/%s/git/refs", repo)
+	out := new(ref)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTagRef(input, out), res, err
+}
+
+func (s *gitService) UpdateBranchProtection(ctx context.Context, repo, name string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, name)
+	in := convertProtectionInput(input)
+	out := new(protection)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertProtection(name, out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type branch struct {
 	Name      string `json:"name"`
 	Commit    commit `json:"commit"`
@@ -106,6 +168,69 @@ type compare struct {
 	Files []*file `json:"files"`
 }
 
+type refInput struct {
+	Ref string `json:"ref"`
+	Sha string `json:"sha"`
+}
+
+type ref struct {
+	Ref    string `json:"ref"`
+	Object struct {
+		Sha  string `json:"sha"`
+		Type string `json:"type"`
+	} `json:"object"`
+}
+
+type tagInput struct {
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+	Object  string `json:"object"`
+	Type    string `json:"type"`
+}
+
+type tagObject struct {
+	Sha string `json:"sha"`
+}
+
+type protection struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	Restrictions *struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+	} `json:"restrictions"`
+}
+
+type protectionInput struct {
+	RequiredStatusChecks       *statusChecksInput `json:"required_status_checks"`
+	EnforceAdmins              *bool              `json:"enforce_admins"`
+	RequiredPullRequestReviews *reviewsInput      `json:"required_pull_request_reviews"`
+	Restrictions               *restrictionsInput `json:"restrictions"`
+}
+
+type statusChecksInput struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type reviewsInput struct {
+	RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+}
+
+type restrictionsInput struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
 func convertCommitList(from []*commit) []*scm.Commit {
 	to := []*scm.Commit{}
 	for _, v := range from {
@@ -152,6 +277,50 @@ func convertBranch(from *branch) *scm.Reference {
 	}
 }
 
+func convertProtection(name string, from *protection) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch: name,
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		to.RequireStatusChecks = true
+		to.StatusChecks = v.Contexts
+	}
+	if v := from.RequiredPullRequestReviews; v != nil {
+		to.RequiredApprovals = v.RequiredApprovingReviewCount
+	}
+	if v := from.Restrictions; v != nil {
+		to.RestrictPush = true
+		for _, user := range v.Users {
+			to.PushUsers = append(to.PushUsers, user.Login)
+		}
+		for _, team := range v.Teams {
+			to.PushTeams = append(to.PushTeams, team.Slug)
+		}
+	}
+	return to
+}
+
+func convertProtectionInput(from *scm.BranchProtection) *protectionInput {
+	to := new(protectionInput)
+	if from.RequireStatusChecks {
+		to.RequiredStatusChecks = &statusChecksInput{
+			Contexts: append([]string{}, from.StatusChecks...),
+		}
+	}
+	if from.RequiredApprovals > 0 {
+		to.RequiredPullRequestReviews = &reviewsInput{
+			RequiredApprovingReviewCount: from.RequiredApprovals,
+		}
+	}
+	if from.RestrictPush {
+		to.Restrictions = &restrictionsInput{
+			Users: append([]string{}, from.PushUsers...),
+			Teams: append([]string{}, from.PushTeams...),
+		}
+	}
+	return to
+}
+
 func convertTagList(from []*branch) []*scm.Reference {
 	to := []*scm.Reference{}
 	for _, v := range from {
@@ -167,3 +336,14 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.Commit.Sha,
 	}
 }
+
+// helper function converts the created tag reference. The
+// commit sha is taken from the input because the reference
+// of an annotated tag points to the tag object.
+func convertTagRef(input *scm.TagInput, from *ref) *scm.Reference {
+	return &scm.Reference{
+		Name: scm.TrimRef(from.Ref),
+		Path: from.Ref,
+		Sha:  input.Sha,
+	}
+}
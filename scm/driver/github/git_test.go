@@ -246,3 +246,238 @@ func TestGitCompareChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestGitFindBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindBranchProtection(context.Background(), "octocat/hello-world", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]string{
+			"ref": "refs/heads/release/1.0",
+			"sha": "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.CreateBranch(context.Background(), "octocat/hello-world", "release/1.0", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitUpdateBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/branches/master/protection").
+		File("testdata/branch_protection_update.json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	in := &scm.BranchProtection{
+		RequireStatusChecks: true,
+		StatusChecks:        []string{"continuous-integration/travis-ci"},
+		RequiredApprovals:   2,
+		RestrictPush:        true,
+		PushUsers:           []string{"octocat"},
+		PushTeams:           []string{"justice-league"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.UpdateBranchProtection(context.Background(), "octocat/hello-world", "master", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/heads/release/1.0").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "octocat/hello-world", "release/1.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]string{
+			"ref": "refs/tags/v1.0.0",
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_ref.json")
+
+	in := &scm.TagInput{
+		Name: "v1.0.0",
+		Sha:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag_ref.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag_Annotated(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/tags").
+		JSON(map[string]string{
+			"tag":     "v1.0.0",
+			"message": "initial version",
+			"object":  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"type":    "commit",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]string{
+			"ref": "refs/tags/v1.0.0",
+			"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_ref.json")
+
+	in := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Message: "initial version",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag_ref.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect tag object created before the reference")
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/tags/v1.0.0").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "octocat/hello-world", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
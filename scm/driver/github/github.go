@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
 	"strings"
@@ -36,10 +37,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGithub
 	client.Linker = &linker{websiteAddress(base)}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
+	client.Releases = &releaseService{client}
 	client.Repositories = &RepositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -70,12 +75,20 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		switch body := in.(type) {
+		case *rawBody:
+			req.Header = map[string][]string{
+				"Content-Type": {body.contentType},
+			}
+			req.Body = body.data
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header = map[string][]string{
+				"Content-Type": {"application/json"},
+			}
+			req.Body = buf
 		}
-		req.Body = buf
 	}
 
 	// execute the http request
@@ -107,7 +120,7 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, convertError(res, err)
 	}
 
 	if out == nil {
@@ -119,15 +132,47 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// rawBody is written to the request body as-is, without
+// json encoding. It is used to upload binary files.
+type rawBody struct {
+	contentType string
+	data        io.Reader
+}
+
 // Error represents a Github error.
 type Error struct {
 	Message string `json:"message"`
+	Errors  []struct {
+		Resource string `json:"resource"`
+		Field    string `json:"field"`
+		Code     string `json:"code"`
+		Message  string `json:"message"`
+	} `json:"errors"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
 
+// helper function converts the github error to the
+// common error type.
+func convertError(res *scm.Response, from *Error) *scm.Error {
+	to := &scm.Error{
+		Status:  res.Status,
+		Driver:  scm.DriverGithub,
+		ID:      res.ID,
+		Message: from.Message,
+	}
+	for _, e := range from.Errors {
+		to.Fields = append(to.Fields, scm.FieldError{
+			Field:   e.Field,
+			Code:    e.Code,
+			Message: e.Message,
+		})
+	}
+	return to
+}
+
 // helper function converts the github API url to
 // the website url.
 func websiteAddress(u *url.URL) string {
@@ -5,10 +5,16 @@
 package github
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/url"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 var mockHeaders = map[string]string{
@@ -59,6 +65,36 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error_validation.json")
+
+	client := NewDefault()
+	_, _, err := client.Issues.Create(context.Background(), "octocat/hello-world", &scm.IssueInput{})
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+	if !errors.Is(err, scm.ErrValidation) {
+		t.Errorf("Expect error matches scm.ErrValidation")
+	}
+
+	want := new(scm.Error)
+	raw, _ := ioutil.ReadFile("testdata/error_validation.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func testRate(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Rate.Limit, 60; got != want {
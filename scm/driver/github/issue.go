@@ -7,9 +7,11 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
 )
 
 type issueService struct {
@@ -46,15 +48,26 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues", repo)
-	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
-	}
+	in := convertIssueInput(input)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := convertIssueInput(input)
+	switch input.State {
+	case scm.IssueStateOpen:
+		in.State = "open"
+	case scm.IssueStateClosed:
+		in.State = "closed"
+	}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/comments", repo, number)
 	in := &issueCommentInput{
@@ -78,6 +91,12 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := &issueInput{State: "open"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/lock", repo, number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -90,6 +109,38 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return res, err
 }
 
+func (s *issueService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: []string{label}}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels/%s", repo, number, url.PathEscape(label))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *issueService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: labels}
+	if in.Labels == nil {
+		in.Labels = []string{}
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *issueService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/assignees", repo, number)
+	in := &assigneesInput{Assignees: logins}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *issueService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/assignees", repo, number)
+	in := &assigneesInput{Assignees: logins}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
 type issue struct {
 	ID      int    `json:"id"`
 	HTMLURL string `json:"html_url"`
@@ -101,17 +152,29 @@ type issue struct {
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"user"`
-	Labels []struct {
-		Name string `json:"name"`
-	} `json:"labels"`
-	Locked    bool      `json:"locked"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Labels      []*label   `json:"labels"`
+	Assignees   []*user    `json:"assignees"`
+	Milestone   *milestone `json:"milestone"`
+	Locked      bool       `json:"locked"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	PullRequest *struct {
+		HTMLURL string `json:"html_url"`
+		DiffURL string `json:"diff_url"`
+	} `json:"pull_request"`
 }
 
 type issueInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Title     string    `json:"title,omitempty"`
+	Body      string    `json:"body,omitempty"`
+	State     string    `json:"state,omitempty"`
+	Labels    *[]string `json:"labels,omitempty"`
+	Assignees *[]string `json:"assignees,omitempty"`
+	Milestone *null.Int `json:"milestone,omitempty"`
+}
+
+type assigneesInput struct {
+	Assignees []string `json:"assignees"`
 }
 
 type issueComment struct {
@@ -131,6 +194,30 @@ type issueCommentInput struct {
 	Body string `json:"body"`
 }
 
+// helper function to convert from the common issue input
+// to the github issue input. The labels and assignees are
+// only sent when set, so that an empty list clears them.
+func convertIssueInput(from *scm.IssueInput) *issueInput {
+	to := &issueInput{
+		Title: from.Title,
+		Body:  from.Body,
+	}
+	// a zero milestone id is sent as null, which removes
+	// the milestone.
+	if from.Milestone != nil {
+		to.Milestone = new(null.Int)
+		to.Milestone.Int64 = int64(*from.Milestone)
+		to.Milestone.Valid = *from.Milestone != 0
+	}
+	if from.Labels != nil {
+		to.Labels = &from.Labels
+	}
+	if from.Assignees != nil {
+		to.Assignees = &from.Assignees
+	}
+	return to
+}
+
 // helper function to convert from the gogs issue list to
 // the common issue structure.
 func convertIssueList(from []*issue) []*scm.Issue {
@@ -149,15 +236,17 @@ func convertIssue(from *issue) *scm.Issue {
 		Title:  from.Title,
 		Body:   from.Body,
 		Link:   from.HTMLURL,
-		Labels: convertLabels(from),
+		Labels: convertLabels(from.Labels),
 		Locked: from.Locked,
 		Closed: from.State == "closed",
 		Author: scm.User{
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
 	}
 }
 
@@ -185,11 +274,3 @@ func convertIssueComment(from *issueComment) *scm.Comment {
 		Updated: from.UpdatedAt,
 	}
 }
-
-func convertLabels(from *issue) []string {
-	var labels []string
-	for _, label := range from.Labels {
-		labels = append(labels, label.Name)
-	}
-	return labels
-}
@@ -150,6 +150,10 @@ func TestIssueCreate(t *testing.T) {
 
 	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/issues").
+		JSON(map[string]string{
+			"title": "Found a bug",
+			"body":  "I'm having a problem with this.",
+		}).
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -180,6 +184,107 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Found a bug",
+			"state":     "closed",
+			"labels":    []string{"bug"},
+			"milestone": 1,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	milestone := 1
+	input := &scm.IssueInput{
+		Title:     "Found a bug",
+		State:     scm.IssueStateClosed,
+		Labels:    []string{"bug"},
+		Milestone: &milestone,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueUpdate_ClearAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{
+			"assignees": []string{},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := &scm.IssueInput{
+		Assignees: []string{},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Issues.Update(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueUpdate_ClearMilestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{
+			"milestone": nil,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	milestone := 0
+	input := &scm.IssueInput{
+		Milestone: &milestone,
+	}
+
+	client := NewDefault()
+	_, _, err := client.Issues.Update(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +361,30 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]string{
+			"state": "open",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	defer gock.Off()
 
@@ -295,3 +424,118 @@ func TestIssueUnlock(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestIssueAddAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1/assignees").
+		JSON(map[string][]string{
+			"assignees": {"octocat"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.AddAssignees(context.Background(), "octocat/hello-world", 1, []string{"octocat"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueRemoveAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1/assignees").
+		JSON(map[string][]string{
+			"assignees": {"octocat"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.RemoveAssignees(context.Background(), "octocat/hello-world", 1, []string{"octocat"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueAddLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1/labels").
+		JSON(map[string][]string{
+			"labels": {"bug"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	res, err := client.Issues.AddLabel(context.Background(), "octocat/hello-world", 1, "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1/labels/good first issue").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	res, err := client.Issues.RemoveLabel(context.Background(), "octocat/hello-world", 1, "good first issue")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueSetLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/issues/1/labels").
+		JSON(map[string][]string{
+			"labels": {},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	res, err := client.Issues.SetLabels(context.Background(), "octocat/hello-world", 1, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+type milestoneService struct {
+	client *wrapper
+}
+
+type milestone struct {
+	ID          int       `json:"id"`
+	Number      int       `json:"number"`
+	HTMLURL     string    `json:"html_url"`
+	State       string    `json:"state"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	DueOn       null.Time `json:"due_on"`
+}
+
+type milestoneInput struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	State       string     `json:"state,omitempty"`
+	DueOn       *time.Time `json:"due_on,omitempty"`
+}
+
+func (s *milestoneService) Find(ctx context.Context, repo string, id int) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones?%s", repo, encodeMilestoneListOptions(opts))
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMilestoneList(out), res, err
+}
+
+func (s *milestoneService) Create(ctx context.Context, repo string, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones", repo)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "POST", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Update(ctx context.Context, repo string, id int, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "PATCH", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Close(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones/%d", repo, id)
+	in := &milestoneInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func convertMilestoneInput(from *scm.MilestoneInput) *milestoneInput {
+	to := &milestoneInput{
+		Title:       from.Title,
+		Description: from.Description,
+	}
+	if !from.DueDate.IsZero() {
+		to.DueOn = &from.DueDate
+	}
+	return to
+}
+
+func convertMilestoneList(from []*milestone) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+// helper function to convert from the github milestone
+// structure to the common milestone structure. It returns
+// nil if the issue or pull request has no milestone.
+func convertMilestone(from *milestone) *scm.Milestone {
+	if from == nil {
+		return nil
+	}
+	return &scm.Milestone{
+		ID:          from.Number,
+		Title:       from.Title,
+		Description: from.Description,
+		Link:        from.HTMLURL,
+		Closed:      from.State == "closed",
+		DueDate:     from.DueOn.ValueOrZero(),
+	}
+}
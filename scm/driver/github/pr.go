@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -38,10 +39,53 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertChangeList(out), res, err
 }
 
-func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+// Merge merges the pull request. GitHub cannot delete the
+// source branch when merging, so the branch is deleted after
+// the merge, unless it is in a fork, and merging once checks
+// pass is not supported.
+func (s *pullService) Merge(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input == nil {
+		input = new(scm.MergeInput)
+	}
+	if input.MergeWhenPipelineSucceeds {
+		return nil, &scm.OptionError{Option: "MergeWhenPipelineSucceeds"}
+	}
+	in := &mergeInput{
+		CommitTitle:   input.Title,
+		CommitMessage: input.Message,
+		Sha:           input.Sha,
+	}
+	switch input.Method {
+	case scm.MergeMethodMerge:
+		in.MergeMethod = "merge"
+	case scm.MergeMethodSquash:
+		in.MergeMethod = "squash"
+	case scm.MergeMethodRebase:
+		in.MergeMethod = "rebase"
+	case scm.MergeMethodFastForward:
+		return nil, &scm.OptionError{Option: "Method"}
+	}
+	head := new(pr)
+	if input.DeleteSourceBranch {
+		path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+		res, err := s.client.do(ctx, "GET", path, nil, head)
+		if err != nil {
+			return res, err
+		}
+	}
 	path := fmt.Sprintf("repos/%s/pulls/%d/merge", repo, number)
-	res, err := s.client.do(ctx, "PUT", path, nil, nil)
-	return res, err
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	if err != nil || !input.DeleteSourceBranch {
+		return res, err
+	}
+	if !strings.EqualFold(head.Head.Repo.FullName, head.Base.Repo.FullName) {
+		return res, nil
+	}
+	path = fmt.Sprintf("repos/%s/git/refs/heads/%s", head.Head.Repo.FullName, head.Head.Ref)
+	if _, err := s.client.do(ctx, "DELETE", path, nil, nil); err != nil {
+		return res, &scm.DeleteBranchError{Branch: head.Head.Ref, Err: err}
+	}
+	return res, nil
 }
 
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -51,6 +95,13 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	res, err := s.client.do(ctx, "PATCH", path, &data, nil)
+	return res, err
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls", repo)
 	in := &prInput{
@@ -58,15 +109,53 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 		Body:  input.Body,
 		Head:  input.Source,
 		Base:  input.Target,
+		Draft: input.Draft,
 	}
 	out := new(pr)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	in := &prUpdateInput{
+		Title: input.Title,
+		Body:  input.Body,
+		Base:  input.Target,
+	}
+	switch input.State {
+	case scm.PullRequestStateOpen:
+		in.State = "open"
+	case scm.PullRequestStateClosed:
+		in.State = "closed"
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
 type pr struct {
 	Number  int    `json:"number"`
 	State   string `json:"state"`
+	Draft   bool   `json:"draft"`
 	Title   string `json:"title"`
 	Body    string `json:"body"`
 	DiffURL string `json:"diff_url"`
@@ -93,14 +182,17 @@ type pr struct {
 			Login     string `json:"login"`
 			AvatarURL string `json:"avatar_url"`
 		}
+		Repo struct {
+			FullName string `json:"full_name"`
+		} `json:"repo"`
 	} `json:"base"`
-	MergedAt  null.String `json:"merged_at"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
-	Labels    []struct {
-		Name  string `json:"name"`
-		Color string `json:"color"`
-	} `json:"labels"`
+	MergedAt           null.String `json:"merged_at"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Labels             []*label    `json:"labels"`
+	Assignees          []*user     `json:"assignees"`
+	RequestedReviewers []*user     `json:"requested_reviewers"`
+	Milestone          *milestone  `json:"milestone"`
 }
 
 type prInput struct {
@@ -108,6 +200,26 @@ type prInput struct {
 	Body  string `json:"body"`
 	Head  string `json:"head"`
 	Base  string `json:"base"`
+	Draft bool   `json:"draft,omitempty"`
+}
+
+type prUpdateInput struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Base  string `json:"base,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+type reviewersInput struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+type mergeInput struct {
+	CommitTitle   string `json:"commit_title,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+	Sha           string `json:"sha,omitempty"`
+	MergeMethod   string `json:"merge_method,omitempty"`
 }
 
 type file struct {
@@ -128,13 +240,6 @@ func convertPullRequestList(from []*pr) []*scm.PullRequest {
 }
 
 func convertPullRequest(from *pr) *scm.PullRequest {
-	var labels []scm.Label
-	for _, label := range from.Labels {
-		labels = append(labels, scm.Label{
-			Name:  label.Name,
-			Color: label.Color,
-		})
-	}
 	return &scm.PullRequest{
 		Number: from.Number,
 		Title:  from.Title,
@@ -148,6 +253,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Diff:   from.DiffURL,
 		Closed: from.State != "open",
 		Merged: from.MergedAt.String != "",
+		Draft:  from.Draft,
 		Head: scm.Reference{
 			Name: from.Head.Ref,
 			Path: scm.ExpandRef(from.Head.Ref, "refs/heads"),
@@ -162,10 +268,42 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
-		Labels:  labels,
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+		Labels:    convertLabels(from.Labels),
+		Reviewers: convertUserList(from.RequestedReviewers),
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+	}
+}
+
+// helper function to convert from the github issue
+// structure to the common pull request structure. This
+// is used for pull request comment events, which github
+// delivers as issue comment events.
+func convertPullRequestFromIssue(from *issue) *scm.PullRequest {
+	dst := &scm.PullRequest{
+		Number: from.Number,
+		Title:  from.Title,
+		Body:   from.Body,
+		Ref:    fmt.Sprintf("refs/pull/%d/head", from.Number),
+		Link:   from.HTMLURL,
+		Closed: from.State == "closed",
+		Author: scm.User{
+			Login:  from.User.Login,
+			Avatar: from.User.AvatarURL,
+		},
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+		Labels:    convertLabels(from.Labels),
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+	}
+	if from.PullRequest != nil {
+		dst.Link = from.PullRequest.HTMLURL
+		dst.Diff = from.PullRequest.DiffURL
 	}
+	return dst
 }
 
 func convertChangeList(from []*file) []*scm.Change {
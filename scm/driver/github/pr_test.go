@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -123,7 +124,7 @@ func TestPullMerge(t *testing.T) {
 		SetHeaders(mockHeaders)
 
 	client := NewDefault()
-	res, err := client.PullRequests.Merge(context.Background(), "octocat/hello-world", 1347)
+	res, err := client.PullRequests.Merge(context.Background(), "octocat/hello-world", 1347, nil)
 	if err != nil {
 		t.Error(err)
 		return
@@ -133,6 +134,128 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMerge_Options(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		JSON(map[string]string{
+			"commit_title":   "Amazing new feature (#1347)",
+			"commit_message": "Please pull these awesome changes",
+			"sha":            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"merge_method":   "squash",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/Hello-World/git/refs/heads/new-topic").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		Title:              "Amazing new feature (#1347)",
+		Message:            "Please pull these awesome changes",
+		Sha:                "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.Merge(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMerge_DeleteBranchError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/Hello-World/git/refs/heads/new-topic").
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "Reference does not exist"}`)
+
+	client := NewDefault()
+	res, err := client.PullRequests.Merge(context.Background(), "octocat/hello-world", 1347, &scm.MergeInput{
+		DeleteSourceBranch: true,
+	})
+	if _, ok := err.(*scm.DeleteBranchError); !ok {
+		t.Errorf("Want DeleteBranchError, got %v", err)
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want merge response status %d, got %d", want, got)
+	}
+}
+
+func TestPullMerge_Fork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"head": {"ref": "new-topic", "repo": {"full_name": "hubot/Hello-World"}}, "base": {"ref": "master", "repo": {"full_name": "octocat/Hello-World"}}}`)
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.PullRequests.Merge(context.Background(), "octocat/hello-world", 1347, &scm.MergeInput{
+		DeleteSourceBranch: true,
+	})
+	if err != nil {
+		t.Errorf("Expect the fork branch not deleted, got %v", err)
+	}
+}
+
+func TestPullMerge_NotSupported(t *testing.T) {
+	inputs := []*scm.MergeInput{
+		{Method: scm.MergeMethodFastForward},
+		{MergeWhenPipelineSucceeds: true},
+	}
+	for _, input := range inputs {
+		_, err := NewDefault().PullRequests.Merge(context.Background(), "octocat/hello-world", 1347, input)
+		if !errors.Is(err, scm.ErrNotSupported) {
+			t.Errorf("Expect Not Supported error, got %v", err)
+		}
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -153,11 +276,82 @@ func TestPullClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		JSON(map[string]string{"state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.Reopen(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		JSON(map[string]string{
+			"title": "Amazing new feature",
+			"body":  "Please pull these awesome changes in!",
+			"base":  "develop",
+			"state": "open",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_update.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Title:  "Amazing new feature",
+		Body:   "Please pull these awesome changes in!",
+		Target: "develop",
+		State:  scm.PullRequestStateOpen,
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Update(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr_update.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/pulls").
+		JSON(map[string]interface{}{
+			"title": "new-feature",
+			"body":  "Please pull these awesome changes",
+			"head":  "new-topic",
+			"base":  "master",
+			"draft": true,
+		}).
 		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -168,6 +362,7 @@ func TestPullCreate(t *testing.T) {
 		Body:   "Please pull these awesome changes",
 		Source: "new-topic",
 		Target: "master",
+		Draft:  true,
 	}
 
 	client := NewDefault()
@@ -189,3 +384,82 @@ func TestPullCreate(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("rate", testRate(res))
 }
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers":      {"octocat"},
+			"team_reviewers": {"justice-league"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"octocat"},
+		Teams: []string{"justice-league"},
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.RequestReviewers(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers": {"octocat"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"octocat"},
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.RemoveReviewers(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullAddAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1347/assignees").
+		JSON(map[string][]string{
+			"assignees": {"octocat"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.AddAssignees(context.Background(), "octocat/hello-world", 1347, []string{"octocat"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,191 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type releaseService struct {
+	client *wrapper
+}
+
+type release struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"name"`
+	Description string    `json:"body"`
+	Link        string    `json:"html_url"`
+	UploadURL   string    `json:"upload_url"`
+	Tag         string    `json:"tag_name"`
+	Commitish   string    `json:"target_commitish"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
+	Created     time.Time `json:"created_at"`
+	Published   time.Time `json:"published_at"`
+}
+
+type releaseInput struct {
+	Title       string `json:"name,omitempty"`
+	Description string `json:"body,omitempty"`
+	Tag         string `json:"tag_name,omitempty"`
+	Commitish   string `json:"target_commitish,omitempty"`
+	Draft       *bool  `json:"draft,omitempty"`
+	Prerelease  *bool  `json:"prerelease,omitempty"`
+}
+
+type releaseAsset struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Link        string    `json:"browser_download_url"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	Created     time.Time `json:"created_at"`
+}
+
+func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/%d", repo, id)
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) FindByTag(ctx context.Context, repo, tag string) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/tags/%s", repo, url.PathEscape(tag))
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases?%s", repo, encodeListOptions(opts))
+	out := []*release{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseList(out), res, err
+}
+
+func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases", repo)
+	in := convertReleaseInput(input)
+	out := new(release)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/%d", repo, id)
+	in := convertReleaseInput(input)
+	out := new(release)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) UpdateByTag(ctx context.Context, repo, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.Update(ctx, repo, rel.ID, input)
+}
+
+func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *releaseService) DeleteByTag(ctx context.Context, repo, tag string) (*scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return res, err
+	}
+	return s.Delete(ctx, repo, rel.ID)
+}
+
+// UploadAsset uploads the release asset. Assets are uploaded
+// to the upload endpoint advertised by the release, which is
+// hosted separately from the api endpoint.
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, id int, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	rel := new(release)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("repos/%s/releases/%d", repo, id), nil, rel)
+	if err != nil {
+		return nil, res, err
+	}
+	// the upload url is a hypermedia template, for example
+	// https://uploads.github.com/repos/octocat/hello-world/releases/1/assets{?name,label}
+	path := rel.UploadURL
+	if i := strings.Index(path, "{"); i != -1 {
+		path = path[:i]
+	}
+	path = path + "?name=" + url.QueryEscape(input.Name)
+
+	// the upload endpoint requires the content length,
+	// which is only known if the body is buffered.
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, input.Data); err != nil {
+		return nil, nil, err
+	}
+	in := &rawBody{
+		contentType: input.ContentType,
+		data:        buf,
+	}
+	if in.contentType == "" {
+		in.contentType = "application/octet-stream"
+	}
+	out := new(releaseAsset)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertReleaseAsset(out), res, err
+}
+
+func convertReleaseInput(from *scm.ReleaseInput) *releaseInput {
+	return &releaseInput{
+		Title:       from.Title,
+		Description: from.Description,
+		Tag:         from.Tag,
+		Commitish:   from.Commitish,
+		Draft:       from.Draft,
+		Prerelease:  from.Prerelease,
+	}
+}
+
+func convertReleaseList(from []*release) []*scm.Release {
+	to := []*scm.Release{}
+	for _, v := range from {
+		to = append(to, convertRelease(v))
+	}
+	return to
+}
+
+func convertRelease(from *release) *scm.Release {
+	return &scm.Release{
+		ID:          from.ID,
+		Title:       from.Title,
+		Description: from.Description,
+		Link:        from.Link,
+		Tag:         from.Tag,
+		Commitish:   from.Commitish,
+		Draft:       from.Draft,
+		Prerelease:  from.Prerelease,
+		Created:     from.Created,
+		Published:   from.Published,
+	}
+}
+
+func convertReleaseAsset(from *releaseAsset) *scm.ReleaseAsset {
+	return &scm.ReleaseAsset{
+		ID:          from.ID,
+		Name:        from.Name,
+		Link:        from.Link,
+		Size:        from.Size,
+		ContentType: from.ContentType,
+		Created:     from.Created,
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.Find(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.FindByTag(context.Background(), "octocat/hello-world", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseFindByTag_Escape(t *testing.T) {
+	defer gock.Off()
+
+	matcher := gock.NewEmptyMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.URL.EscapedPath() == "/repos/octocat/hello-world/releases/tags/release%2F1.0", nil
+	})
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/tags/").
+		SetMatcher(matcher).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	_, _, err := client.Releases.FindByTag(context.Background(), "octocat/hello-world", "release/1.0")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/releases.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.List(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReleaseCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/releases").
+		File("testdata/release_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "master",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.Create(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUpdateByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/releases/1").
+		File("testdata/release_create.json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "master",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UpdateByTag(context.Background(), "octocat/hello-world", "v1.0.0", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUpdate_Partial(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/releases/1").
+		JSON(map[string]interface{}{
+			"body":  "Updated description",
+			"draft": false,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	draft := false
+	in := &scm.ReleaseInput{
+		Description: "Updated description",
+		Draft:       &draft,
+	}
+
+	client := NewDefault()
+	_, _, err := client.Releases.Update(context.Background(), "octocat/hello-world", 1, in)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestReleaseDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/releases/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Releases.Delete(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	gock.New("https://uploads.github.com").
+		Post("/repos/octocat/hello-world/releases/1/assets").
+		MatchParam("name", "example.txt").
+		MatchType("text/plain").
+		BodyString("hello world").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_asset.json")
+
+	in := &scm.ReleaseAssetInput{
+		Name:        "example.txt",
+		ContentType: "text/plain",
+		Data:        strings.NewReader("hello world"),
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UploadAsset(context.Background(), "octocat/hello-world", 1, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/release_asset.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -50,6 +50,20 @@ type hook struct {
 	} `json:"config"`
 }
 
+type key struct {
+	ID       int       `json:"id"`
+	Title    string    `json:"title"`
+	Key      string    `json:"key"`
+	ReadOnly bool      `json:"read_only"`
+	Created  time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
 // RepositoryService implements the repository service for
 // the GitHub driver.
 type RepositoryService struct {
@@ -72,6 +86,14 @@ func (s *RepositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+// FindKey returns a repository deploy key.
+func (s *RepositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 // FindPerms returns the repository permissions.
 func (s *RepositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s", repo)
@@ -96,6 +118,14 @@ func (s *RepositoryService) ListHooks(ctx context.Context, repo string, opts scm
 	return convertHookList(out), res, err
 }
 
+// ListKeys returns a list of repository deploy keys.
+func (s *RepositoryService) ListKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys?%s", repo, encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
 // ListStatus returns a list of commit statuses.
 func (s *RepositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/statuses/%s?%s", repo, ref, encodeListOptions(opts))
@@ -125,6 +155,19 @@ func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateKey creates a new repository deploy key.
+func (s *RepositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys", repo)
+	in := &keyInput{
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 // CreateStatus creates a new commit status.
 func (s *RepositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/statuses/%s", repo, ref)
@@ -180,6 +223,12 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// DeleteKey deletes a repository deploy key.
+func (s *RepositoryService) DeleteKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -230,6 +279,24 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(from []*key) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: from.ReadOnly,
+		Created:  from.Created,
+	}
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -35,7 +35,8 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 	in := &reviewInput{
 		Body:     input.Body,
 		Path:     input.Path,
-		Position: input.Line,
+		Line:     input.Line,
+		Side:     convertFromReviewSide(input.Side),
 		CommitID: input.Sha,
 	}
 	out := new(review)
@@ -48,11 +49,54 @@ func (s *reviewService) Delete(ctx context.Context, repo string, number, id int)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *reviewService) ListSummaries(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*reviewSummary{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewSummaryList(out), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSummaryInput) (*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews", repo, number)
+	in := &reviewSummaryInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Event:    convertFromReviewState(input.State),
+		Comments: []*reviewCommentInput{},
+	}
+	for _, c := range input.Comments {
+		in.Comments = append(in.Comments, &reviewCommentInput{
+			Body: c.Body,
+			Path: c.Path,
+			Line: c.Line,
+			Side: convertFromReviewSide(c.Side),
+		})
+	}
+	out := new(reviewSummary)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewSummary(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &reviewDismissInput{
+		Message: message,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *reviewService) FindApprovals(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type review struct {
 	ID       int    `json:"id"`
 	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
+	Line     int    `json:"line"`
+	Original int    `json:"original_line"`
+	Side     string `json:"side"`
 	Path     string `json:"path"`
+	HTMLURL  string `json:"html_url"`
 	User     struct {
 		ID        int    `json:"id"`
 		Login     string `json:"login"`
@@ -67,7 +111,40 @@ type reviewInput struct {
 	Body     string `json:"body"`
 	Path     string `json:"path"`
 	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
+	Line     int    `json:"line"`
+	Side     string `json:"side"`
+}
+
+type reviewSummary struct {
+	ID       int    `json:"id"`
+	Body     string `json:"body"`
+	State    string `json:"state"`
+	CommitID string `json:"commit_id"`
+	HTMLURL  string `json:"html_url"`
+	User     struct {
+		ID        int    `json:"id"`
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"user"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type reviewSummaryInput struct {
+	Body     string                `json:"body,omitempty"`
+	CommitID string                `json:"commit_id,omitempty"`
+	Event    string                `json:"event,omitempty"`
+	Comments []*reviewCommentInput `json:"comments"`
+}
+
+type reviewCommentInput struct {
+	Body string `json:"body"`
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Side string `json:"side"`
+}
+
+type reviewDismissInput struct {
+	Message string `json:"message"`
 }
 
 func convertReviewList(from []*review) []*scm.Review {
@@ -79,12 +156,20 @@ func convertReviewList(from []*review) []*scm.Review {
 }
 
 func convertReview(from *review) *scm.Review {
+	// the line is null when the comment is outdated, in
+	// which case the original line is used.
+	line := from.Line
+	if line == 0 {
+		line = from.Original
+	}
 	return &scm.Review{
 		ID:   from.ID,
 		Body: from.Body,
 		Path: from.Path,
-		Line: from.Position,
+		Line: line,
+		Side: convertReviewSide(from.Side),
 		Sha:  from.CommitID,
+		Link: from.HTMLURL,
 		Author: scm.User{
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
@@ -93,3 +178,70 @@ func convertReview(from *review) *scm.Review {
 		Updated: from.UpdatedAt,
 	}
 }
+
+func convertReviewSummaryList(from []*reviewSummary) []*scm.ReviewSummary {
+	to := []*scm.ReviewSummary{}
+	for _, v := range from {
+		to = append(to, convertReviewSummary(v))
+	}
+	return to
+}
+
+func convertReviewSummary(from *reviewSummary) *scm.ReviewSummary {
+	return &scm.ReviewSummary{
+		ID:    from.ID,
+		State: convertReviewState(from.State),
+		Body:  from.Body,
+		Sha:   from.CommitID,
+		Link:  from.HTMLURL,
+		Author: scm.User{
+			Login:  from.User.Login,
+			Avatar: from.User.AvatarURL,
+		},
+		Created: from.SubmittedAt,
+	}
+}
+
+func convertReviewState(from string) scm.ReviewState {
+	switch from {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "CHANGES_REQUESTED":
+		return scm.ReviewStateChangesRequested
+	case "COMMENTED":
+		return scm.ReviewStateCommented
+	case "DISMISSED":
+		return scm.ReviewStateDismissed
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// helper function returns the review event for the state.
+// An empty event creates a pending review.
+func convertFromReviewState(from scm.ReviewState) string {
+	switch from {
+	case scm.ReviewStateApproved:
+		return "APPROVE"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	case scm.ReviewStateCommented:
+		return "COMMENT"
+	default:
+		return ""
+	}
+}
+
+func convertReviewSide(from string) scm.ReviewSide {
+	if from == "LEFT" {
+		return scm.ReviewSideOld
+	}
+	return scm.ReviewSideNew
+}
+
+func convertFromReviewSide(from scm.ReviewSide) string {
+	if from == scm.ReviewSideOld {
+		return "LEFT"
+	}
+	return "RIGHT"
+}
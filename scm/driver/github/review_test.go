@@ -84,6 +84,13 @@ func TestReviewCreate(t *testing.T) {
 
 	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/pulls/1/comments").
+		JSON(map[string]interface{}{
+			"body":      "what?",
+			"path":      "file1.txt",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"line":      18,
+			"side":      "RIGHT",
+		}).
 		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -91,7 +98,7 @@ func TestReviewCreate(t *testing.T) {
 
 	input := &scm.ReviewInput{
 		Body: "what?",
-		Line: 1,
+		Line: 18,
 		Path: "file1.txt",
 		Sha:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
 	}
@@ -135,3 +142,106 @@ func TestReviewDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReviewListSummaries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/12/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/review_summaries.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListSummaries(context.Background(), "octocat/hello-world", 12, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/review_summaries.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/12/reviews").
+		JSON(map[string]interface{}{
+			"body":      "Looks good to me.",
+			"commit_id": "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+			"event":     "APPROVE",
+			"comments": []map[string]interface{}{
+				{"body": "Nice refactor", "path": "main.go", "line": 4, "side": "LEFT"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_summary.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateApproved,
+		Body:  "Looks good to me.",
+		Sha:   "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+		Comments: []*scm.ReviewInput{
+			{Body: "Nice refactor", Path: "main.go", Line: 4, Side: scm.ReviewSideOld},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Submit(context.Background(), "octocat/hello-world", 12, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewSummary)
+	raw, _ := ioutil.ReadFile("testdata/review_summary.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/12/reviews/80/dismissals").
+		JSON(map[string]string{"message": "The pull request was updated"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_summary.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.Dismiss(context.Background(), "octocat/hello-world", 12, 80, "The pull request was updated")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -55,3 +55,13 @@ func convertUser(from *user) *scm.User {
 		Updated: from.Updated,
 	}
 }
+
+// helper function returns the users in the list, or nil
+// if the list is empty.
+func convertUserList(from []*user) []scm.User {
+	var to []scm.User
+	for _, v := range from {
+		to = append(to, *convertUser(v))
+	}
+	return to
+}
@@ -52,6 +52,22 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	return params.Encode()
 }
 
+func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Open && opts.Closed {
+		params.Set("state", "all")
+	} else if opts.Closed {
+		params.Set("state", "closed")
+	}
+	return params.Encode()
+}
+
 func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -67,3 +83,26 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Sha != "" {
+		params.Set("sha", opts.Sha)
+	}
+	if opts.Ref != "" {
+		params.Set("ref", opts.Ref)
+	}
+	if opts.Task != "" {
+		params.Set("task", opts.Task)
+	}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	return params.Encode()
+}
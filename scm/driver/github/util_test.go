@@ -92,3 +92,16 @@ func Test_encodePullRequestListOptions_Closed(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodeMilestoneListOptions(t *testing.T) {
+	opts := scm.MilestoneListOptions{
+		Page:   10,
+		Size:   30,
+		Closed: true,
+	}
+	want := "page=10&per_page=30&state=closed"
+	got := encodeMilestoneListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded milestone list options %q, got %q", want, got)
+	}
+}
@@ -39,11 +39,14 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseDeleteHook(data)
 	case "pull_request":
 		hook, err = s.parsePullRequestHook(data)
+	case "pull_request_review_comment":
+		hook, err = s.parseReviewCommentHook(data)
+	case "issues":
+		hook, err = s.parseIssueHook(data)
+	case "issue_comment":
+		hook, err = s.parseIssueCommentHook(data)
 	case "deployment":
 		hook, err = s.parseDeploymentHook(data)
-	// case "pull_request_review_comment":
-	// case "issues":
-	// case "issue_comment":
 	default:
 		return nil, scm.ErrUnknownEvent
 	}
@@ -147,6 +150,64 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return dst, nil
 }
 
+func (s *webhookService) parseReviewCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(reviewCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertReviewCommentHook(src)
+	dst.Action = convertCommentAction(src.Action)
+	return dst, nil
+}
+
+func (s *webhookService) parseIssueHook(data []byte) (scm.Webhook, error) {
+	src := new(issueHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertIssueHook(src)
+	switch src.Action {
+	case "labeled":
+		dst.Action = scm.ActionLabel
+	case "unlabeled":
+		dst.Action = scm.ActionUnlabel
+	case "opened":
+		dst.Action = scm.ActionOpen
+	case "edited":
+		dst.Action = scm.ActionUpdate
+	case "deleted":
+		dst.Action = scm.ActionDelete
+	case "closed":
+		dst.Action = scm.ActionClose
+	case "reopened":
+		dst.Action = scm.ActionReopen
+	default:
+		return nil, scm.ErrUnknownEvent
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseIssueCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(issueCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	// github sends pull request comments as issue
+	// comments, with a pull_request object attached
+	// to the issue.
+	if src.Issue.PullRequest != nil {
+		dst := convertPullRequestCommentHook(src)
+		dst.Action = convertCommentAction(src.Action)
+		return dst, nil
+	}
+	dst := convertIssueCommentHook(src)
+	dst.Action = convertCommentAction(src.Action)
+	return dst, nil
+}
+
 //
 // native data structures
 //
@@ -236,6 +297,32 @@ type (
 		Sender      user       `json:"sender"`
 	}
 
+	// github issues webhook payload
+	issueHook struct {
+		Action     string     `json:"action"`
+		Issue      issue      `json:"issue"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github issue_comment webhook payload
+	issueCommentHook struct {
+		Action     string       `json:"action"`
+		Issue      issue        `json:"issue"`
+		Comment    issueComment `json:"comment"`
+		Repository repository   `json:"repository"`
+		Sender     user         `json:"sender"`
+	}
+
+	// github pull_request_review_comment webhook payload
+	reviewCommentHook struct {
+		Action      string     `json:"action"`
+		PullRequest pr         `json:"pull_request"`
+		Comment     review     `json:"comment"`
+		Repository  repository `json:"repository"`
+		Sender      user       `json:"sender"`
+	}
+
 	// github deployment webhook payload
 	deploymentHook struct {
 		Deployment struct {
@@ -380,6 +467,92 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertIssueHook(src *issueHook) *scm.IssueHook {
+	return &scm.IssueHook{
+		Repo: scm.Repository{
+			ID:        fmt.Sprint(src.Repository.ID),
+			Namespace: src.Repository.Owner.Login,
+			Name:      src.Repository.Name,
+			Branch:    src.Repository.DefaultBranch,
+			Private:   src.Repository.Private,
+			Clone:     src.Repository.CloneURL,
+			CloneSSH:  src.Repository.SSHURL,
+			Link:      src.Repository.HTMLURL,
+		},
+		Issue:  *convertIssue(&src.Issue),
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertIssueCommentHook(src *issueCommentHook) *scm.IssueCommentHook {
+	return &scm.IssueCommentHook{
+		Repo: scm.Repository{
+			ID:        fmt.Sprint(src.Repository.ID),
+			Namespace: src.Repository.Owner.Login,
+			Name:      src.Repository.Name,
+			Branch:    src.Repository.DefaultBranch,
+			Private:   src.Repository.Private,
+			Clone:     src.Repository.CloneURL,
+			CloneSSH:  src.Repository.SSHURL,
+			Link:      src.Repository.HTMLURL,
+		},
+		Issue:   *convertIssue(&src.Issue),
+		Comment: *convertIssueComment(&src.Comment),
+		Sender:  *convertUser(&src.Sender),
+	}
+}
+
+func convertPullRequestCommentHook(src *issueCommentHook) *scm.PullRequestCommentHook {
+	return &scm.PullRequestCommentHook{
+		Repo: scm.Repository{
+			ID:        fmt.Sprint(src.Repository.ID),
+			Namespace: src.Repository.Owner.Login,
+			Name:      src.Repository.Name,
+			Branch:    src.Repository.DefaultBranch,
+			Private:   src.Repository.Private,
+			Clone:     src.Repository.CloneURL,
+			CloneSSH:  src.Repository.SSHURL,
+			Link:      src.Repository.HTMLURL,
+		},
+		PullRequest: *convertPullRequestFromIssue(&src.Issue),
+		Comment:     *convertIssueComment(&src.Comment),
+		Sender:      *convertUser(&src.Sender),
+	}
+}
+
+func convertReviewCommentHook(src *reviewCommentHook) *scm.ReviewCommentHook {
+	return &scm.ReviewCommentHook{
+		Repo: scm.Repository{
+			ID:        fmt.Sprint(src.Repository.ID),
+			Namespace: src.Repository.Owner.Login,
+			Name:      src.Repository.Name,
+			Branch:    src.Repository.DefaultBranch,
+			Private:   src.Repository.Private,
+			Clone:     src.Repository.CloneURL,
+			CloneSSH:  src.Repository.SSHURL,
+			Link:      src.Repository.HTMLURL,
+		},
+		PullRequest: *convertPullRequest(&src.PullRequest),
+		Review:      *convertReview(&src.Comment),
+		Sender:      *convertUser(&src.Sender),
+	}
+}
+
+// helper function converts the github comment action
+// to the common action enum.
+func convertCommentAction(src string) scm.Action {
+	switch src {
+	case "created":
+		return scm.ActionCreate
+	case "edited":
+		return scm.ActionUpdate
+	case "deleted":
+		return scm.ActionDelete
+	default:
+		return 0
+	}
+}
+
 func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	dst := &scm.DeployHook{
 		Number: src.Deployment.ID,
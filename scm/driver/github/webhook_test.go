@@ -157,6 +157,51 @@ func TestWebhooks(t *testing.T) {
 			obj:    new(scm.PullRequestHook),
 		},
 
+		//
+		// pull request comment events
+		//
+
+		// pull request comment created
+		{
+			event:  "issue_comment",
+			before: "testdata/webhooks/pr_comment_created.json",
+			after:  "testdata/webhooks/pr_comment_created.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request review comment created
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/pr_review_comment_created.json",
+			after:  "testdata/webhooks/pr_review_comment_created.json.golden",
+			obj:    new(scm.ReviewCommentHook),
+		},
+
+		//
+		// issue events
+		//
+
+		// issue opened
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_opened.json",
+			after:  "testdata/webhooks/issues_opened.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue closed
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_closed.json",
+			after:  "testdata/webhooks/issues_closed.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue comment created
+		{
+			event:  "issue_comment",
+			before: "testdata/webhooks/issue_comment_created.json",
+			after:  "testdata/webhooks/issue_comment_created.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
+
 		//
 		// deployment
 		//
@@ -242,6 +287,19 @@ func TestWebhook_ErrUnknownEvent(t *testing.T) {
 	}
 }
 
+func TestWebhook_IssueUnknownAction(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/issues_opened.json")
+	f = bytes.Replace(f, []byte(`"action": "opened"`), []byte(`"action": "assigned"`), 1)
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "issues")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
 func TestWebhookInvalid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
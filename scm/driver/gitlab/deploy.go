@@ -0,0 +1,190 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+type deployment struct {
+	ID          int64     `json:"id"`
+	IID         int64     `json:"iid"`
+	Ref         string    `json:"ref"`
+	Sha         string    `json:"sha"`
+	Status      string    `json:"status"`
+	User        user      `json:"user"`
+	Created     time.Time `json:"created_at"`
+	Updated     time.Time `json:"updated_at"`
+	Environment struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		ExternalURL string `json:"external_url"`
+	} `json:"environment"`
+	Deployable *struct {
+		WebURL string `json:"web_url"`
+	} `json:"deployable"`
+}
+
+type deploymentInput struct {
+	Environment string `json:"environment"`
+	Sha         string `json:"sha"`
+	Ref         string `json:"ref"`
+	Tag         bool   `json:"tag"`
+	Status      string `json:"status"`
+}
+
+type deploymentStatusInput struct {
+	Status string `json:"status"`
+}
+
+type commitRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo string, id int64) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+// List returns the project deployments. GitLab filters
+// deployments by environment only, and the Sha, Ref and Task
+// options return an OptionError.
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	switch {
+	case opts.Sha != "":
+		return nil, nil, &scm.OptionError{Option: "Sha"}
+	case opts.Ref != "":
+		return nil, nil, &scm.OptionError{Option: "Ref"}
+	case opts.Task != "":
+		return nil, nil, &scm.OptionError{Option: "Task"}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/deployments?%s", encode(repo), encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+// Create creates a deployment. GitLab requires both the
+// commit sha and the reference name, and the missing one is
+// resolved from the other.
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	if input.Ref == "" && input.Sha == "" {
+		return nil, nil, &scm.OptionError{Option: "Ref"}
+	}
+	in := &deploymentInput{
+		Environment: input.Environment,
+		Sha:         input.Sha,
+		Ref:         scm.TrimRef(input.Ref),
+		Tag:         scm.IsTag(input.Ref),
+		Status:      "created",
+	}
+	// if the reference is not provided it is resolved to the
+	// first branch or tag that contains the commit.
+	if in.Ref == "" {
+		path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/refs?type=all", encode(repo), in.Sha)
+		out := []*commitRef{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		if len(out) == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		in.Ref = out[0].Name
+		in.Tag = out[0].Type == "tag"
+	}
+	// if the sha is not provided it is resolved from the
+	// reference.
+	if in.Sha == "" {
+		commit, res, err := s.client.Git.FindCommit(ctx, repo, input.Ref)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Sha = commit.Sha
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/deployments", encode(repo))
+	out := new(deployment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+// CreateStatus updates the deployment status. GitLab does not
+// allow a deployment to return to the pending (created)
+// state, and StatePending returns an OptionError.
+func (s *deploymentService) CreateStatus(ctx context.Context, repo string, id int64, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	if input.State == scm.StatePending {
+		return nil, nil, &scm.OptionError{Option: "State"}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	in := &deploymentStatusInput{
+		Status: convertFromState(input.State),
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+// ListStatuses returns the deployment status. GitLab tracks a
+// single status per deployment, which is returned as a list
+// with one entry.
+func (s *deploymentService) ListStatuses(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return []*scm.DeployStatus{convertDeployStatus(out)}, res, err
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	return &scm.Deployment{
+		Number:      from.ID,
+		Ref:         from.Ref,
+		Sha:         from.Sha,
+		Environment: from.Environment.Name,
+		Author:      *convertUser(&from.User),
+		Created:     from.Created,
+		Updated:     from.Updated,
+	}
+}
+
+func convertDeployStatus(from *deployment) *scm.DeployStatus {
+	to := &scm.DeployStatus{
+		Number:         from.ID,
+		State:          convertDeployState(from.Status),
+		Environment:    from.Environment.Name,
+		EnvironmentURL: from.Environment.ExternalURL,
+	}
+	if from.Deployable != nil {
+		to.Target = from.Deployable.WebURL
+	}
+	return to
+}
+
+func convertDeployState(from string) scm.State {
+	switch from {
+	case "created":
+		return scm.StatePending
+	default:
+		return convertState(from)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Find(context.Background(), "diaspora/diaspora", 42)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deployment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments").
+		MatchParam("environment", "production").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deployments.json")
+
+	client := NewDefault()
+	opts := scm.DeploymentListOptions{Environment: "production", Page: 1, Size: 30}
+	got, res, err := client.Deployments.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := ioutil.ReadFile("testdata/deployments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeploymentList_Unsupported(t *testing.T) {
+	tests := []struct {
+		opts   scm.DeploymentListOptions
+		option string
+	}{
+		{scm.DeploymentListOptions{Sha: "6104942438c14ec7bd21c6cd5bd995272b3faff6"}, "Sha"},
+		{scm.DeploymentListOptions{Ref: "master"}, "Ref"},
+		{scm.DeploymentListOptions{Task: "deploy"}, "Task"},
+	}
+	client := NewDefault()
+	for _, test := range tests {
+		_, _, err := client.Deployments.List(context.Background(), "diaspora/diaspora", test.opts)
+		if err, ok := err.(*scm.OptionError); !ok || err.Option != test.option {
+			t.Errorf("Want %s OptionError, got %v", test.option, err)
+		}
+	}
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/deployments").
+		File("testdata/deployment_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	in := &scm.DeploymentInput{
+		Ref:         "refs/heads/master",
+		Sha:         "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Environment: "production",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deployment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentCreate_ResolveSha(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/deployments").
+		JSON(map[string]interface{}{
+			"environment": "production",
+			"sha":         "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+			"ref":         "master",
+			"tag":         false,
+			"status":      "created",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	in := &scm.DeploymentInput{
+		Ref:         "master",
+		Environment: "production",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect commit sha resolved from the reference")
+	}
+}
+
+func TestDeploymentCreate_ResolveRef(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/refs").
+		MatchParam("type", "all").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"type": "tag", "name": "v1.0.0"}, {"type": "branch", "name": "master"}]`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/deployments").
+		JSON(map[string]interface{}{
+			"environment": "production",
+			"sha":         "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+			"ref":         "v1.0.0",
+			"tag":         true,
+			"status":      "created",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	in := &scm.DeploymentInput{
+		Sha:         "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+		Environment: "production",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reference resolved from the commit sha")
+	}
+}
+
+func TestDeploymentCreate_NoRef(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", &scm.DeploymentInput{
+		Environment: "production",
+	})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Ref" {
+		t.Errorf("Want Ref OptionError, got %v", err)
+	}
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/deployments/42").
+		JSON(map[string]string{"status": "success"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	in := &scm.DeployStatusInput{
+		State: scm.StateSuccess,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.CreateStatus(context.Background(), "diaspora/diaspora", 42, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployStatus)
+	raw, _ := ioutil.ReadFile("testdata/deployment_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentCreateStatus_Pending(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.CreateStatus(context.Background(), "diaspora/diaspora", 42, &scm.DeployStatusInput{
+		State: scm.StatePending,
+	})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "State" {
+		t.Errorf("Want State OptionError, got %v", err)
+	}
+}
+
+func TestDeploymentListStatuses(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListStatuses(context.Background(), "diaspora/diaspora", 42, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployStatus)
+	raw, _ := ioutil.ReadFile("testdata/deployment_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, []*scm.DeployStatus{want}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
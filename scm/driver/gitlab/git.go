@@ -37,6 +37,13 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+func (s *gitService) FindBranchProtection(ctx context.Context, repo, name string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), encodePath(name))
+	out := new(protectedBranch)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertProtectedBranch(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches?%s", encode(repo), encodeListOptions(opts))
 	out := []*branch{}
@@ -72,6 +79,82 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Diffs), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo, name, sha string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/branches", encode(repo))
+	in := &branchInput{
+		Branch: name,
+		Ref:    sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags", encode(repo))
+	in := &tagInput{
+		Name:    input.Name,
+		Ref:     input.Sha,
+		Message: input.Message,
+	}
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTag(out), res, err
+}
+
+// UpdateBranchProtection protects the branch. An existing
+// rule is updated in place, so the branch stays protected if
+// the update fails. GitLab protected branches do not support
+// required status checks, required approvals or per-user push
+// rules, and these options return an OptionError.
+func (s *gitService) UpdateBranchProtection(ctx context.Context, repo, name string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	switch {
+	case input.RequireStatusChecks, len(input.StatusChecks) != 0:
+		return nil, nil, &scm.OptionError{Option: "StatusChecks"}
+	case input.RequiredApprovals != 0:
+		return nil, nil, &scm.OptionError{Option: "RequiredApprovals"}
+	case len(input.PushUsers) != 0:
+		return nil, nil, &scm.OptionError{Option: "PushUsers"}
+	case len(input.PushTeams) != 0:
+		return nil, nil, &scm.OptionError{Option: "PushTeams"}
+	}
+	level := accessLevelDeveloper
+	if input.RestrictPush {
+		level = accessLevelMaintainer
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), encodePath(name))
+	current := new(protectedBranch)
+	res, err := s.client.do(ctx, "GET", path, nil, current)
+	if err != nil && (res == nil || res.Status != 404) {
+		return nil, res, err
+	}
+	out := new(protectedBranch)
+	if err != nil {
+		path = fmt.Sprintf("api/v4/projects/%s/protected_branches", encode(repo))
+		in := &protectedBranchInput{
+			Name:             name,
+			PushAccessLevel:  level,
+			MergeAccessLevel: level,
+		}
+		res, err = s.client.do(ctx, "POST", path, in, out)
+		return convertProtectedBranch(out), res, err
+	}
+	in := &protectedBranchUpdateInput{
+		AllowedToPush:  replaceAccessLevels(current.PushAccessLevels, level),
+		AllowedToMerge: replaceAccessLevels(current.MergeAccessLevels, level),
+	}
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertProtectedBranch(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), encodePath(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), encodePath(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type branch struct {
 	Name   string `json:"name"`
 	Commit struct {
@@ -96,6 +179,51 @@ type compare struct {
 	Diffs []*change `json:"diffs"`
 }
 
+type branchInput struct {
+	Branch string `json:"branch"`
+	Ref    string `json:"ref"`
+}
+
+type tagInput struct {
+	Name    string `json:"tag_name"`
+	Ref     string `json:"ref"`
+	Message string `json:"message,omitempty"`
+}
+
+// access levels used by protected branches.
+const (
+	accessLevelDeveloper  = 30
+	accessLevelMaintainer = 40
+)
+
+type protectedBranch struct {
+	Name              string         `json:"name"`
+	PushAccessLevels  []*accessLevel `json:"push_access_levels"`
+	MergeAccessLevels []*accessLevel `json:"merge_access_levels"`
+}
+
+type accessLevel struct {
+	ID          int `json:"id"`
+	AccessLevel int `json:"access_level"`
+}
+
+type protectedBranchInput struct {
+	Name             string `json:"name"`
+	PushAccessLevel  int    `json:"push_access_level"`
+	MergeAccessLevel int    `json:"merge_access_level"`
+}
+
+type protectedBranchUpdateInput struct {
+	AllowedToPush  []*accessLevelInput `json:"allowed_to_push"`
+	AllowedToMerge []*accessLevelInput `json:"allowed_to_merge"`
+}
+
+type accessLevelInput struct {
+	ID          int  `json:"id,omitempty"`
+	AccessLevel int  `json:"access_level,omitempty"`
+	Destroy     bool `json:"_destroy,omitempty"`
+}
+
 func convertCommitList(from []*commit) []*scm.Commit {
 	to := []*scm.Commit{}
 	for _, v := range from {
@@ -139,6 +267,29 @@ func convertBranch(from *branch) *scm.Reference {
 	}
 }
 
+// helper function returns the access level changes that
+// replace the current access levels with the given level.
+func replaceAccessLevels(from []*accessLevel, level int) []*accessLevelInput {
+	to := []*accessLevelInput{}
+	for _, v := range from {
+		to = append(to, &accessLevelInput{ID: v.ID, Destroy: true})
+	}
+	return append(to, &accessLevelInput{AccessLevel: level})
+}
+
+func convertProtectedBranch(from *protectedBranch) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:       from.Name,
+		RestrictPush: true,
+	}
+	for _, v := range from.PushAccessLevels {
+		if v.AccessLevel == accessLevelDeveloper {
+			to.RestrictPush = false
+		}
+	}
+	return to
+}
+
 func convertTagList(from []*branch) []*scm.Reference {
 	to := []*scm.Reference{}
 	for _, v := range from {
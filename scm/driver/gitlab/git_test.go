@@ -274,3 +274,250 @@ func TestGitCompareChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestGitFindBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindBranchProtection(context.Background(), "diaspora/diaspora", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protected_branch.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/branches").
+		JSON(map[string]string{
+			"branch": "release-1.0",
+			"ref":    "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.CreateBranch(context.Background(), "diaspora/diaspora", "release-1.0", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitUpdateBranchProtection(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 Not found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/protected_branches").
+		JSON(map[string]interface{}{
+			"name":               "master",
+			"push_access_level":  40,
+			"merge_access_level": 40,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	in := &scm.BranchProtection{
+		RestrictPush: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.UpdateBranchProtection(context.Background(), "diaspora/diaspora", "master", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protected_branch.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitUpdateBranchProtection_Existing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Patch("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		JSON(map[string]interface{}{
+			"allowed_to_push": []map[string]interface{}{
+				{"id": 1001, "_destroy": true},
+				{"access_level": 30},
+			},
+			"allowed_to_merge": []map[string]interface{}{
+				{"id": 1002, "_destroy": true},
+				{"access_level": 30},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	client := NewDefault()
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "diaspora/diaspora", "master", &scm.BranchProtection{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestGitUpdateBranchProtection_Unsupported(t *testing.T) {
+	tests := []struct {
+		input  *scm.BranchProtection
+		option string
+	}{
+		{&scm.BranchProtection{RequireStatusChecks: true}, "StatusChecks"},
+		{&scm.BranchProtection{RequiredApprovals: 2}, "RequiredApprovals"},
+		{&scm.BranchProtection{PushUsers: []string{"john_smith"}}, "PushUsers"},
+		{&scm.BranchProtection{PushTeams: []string{"core"}}, "PushTeams"},
+	}
+	client := NewDefault()
+	for _, test := range tests {
+		_, _, err := client.Git.UpdateBranchProtection(context.Background(), "diaspora/diaspora", "master", test.input)
+		if err, ok := err.(*scm.OptionError); !ok || err.Option != test.option {
+			t.Errorf("Want OptionError for %s, got %v", test.option, err)
+		}
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/branches/release-1.0").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "diaspora/diaspora", "release-1.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/tags").
+		JSON(map[string]string{
+			"tag_name": "v1.0.0",
+			"ref":      "2695effb5807a22ff3d138d593fd856244e155e7",
+			"message":  "initial version",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag.json")
+
+	in := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "2695effb5807a22ff3d138d593fd856244e155e7",
+		Message: "initial version",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.CreateTag(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/tags/v1.0.0").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
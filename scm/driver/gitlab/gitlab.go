@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -31,10 +33,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitlab
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
+	client.Releases = &releaseService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -66,12 +72,20 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		switch body := in.(type) {
+		case *rawBody:
+			req.Header = map[string][]string{
+				"Content-Type": {body.contentType},
+			}
+			req.Body = body.data
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header = map[string][]string{
+				"Content-Type": {"application/json"},
+			}
+			req.Body = buf
 		}
-		req.Body = buf
 	}
 
 	// execute the http request
@@ -84,6 +98,18 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// parse the gitlab request id.
 	res.ID = res.Header.Get("X-Request-Id")
 
+	// parse the gitlab pagination headers, which are sent
+	// in place of the link header for some endpoints.
+	if res.Page.Next == 0 {
+		res.Page.Next, _ = strconv.Atoi(res.Header.Get("X-Next-Page"))
+	}
+	if res.Page.Prev == 0 {
+		res.Page.Prev, _ = strconv.Atoi(res.Header.Get("X-Prev-Page"))
+	}
+	if res.Page.Last == 0 {
+		res.Page.Last, _ = strconv.Atoi(res.Header.Get("X-Total-Pages"))
+	}
+
 	// parse the gitlab rate limit details.
 	res.Rate.Limit, _ = strconv.Atoi(
 		res.Header.Get("RateLimit-Limit"),
@@ -103,7 +129,7 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, convertError(res, err)
 	}
 
 	if out == nil {
@@ -115,11 +141,80 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// rawBody is written to the request body as-is, without
+// json encoding. It is used to upload multipart forms.
+type rawBody struct {
+	contentType string
+	data        io.Reader
+}
+
 // Error represents a GitLab error.
 type Error struct {
-	Message string `json:"message"`
+	Message string              `json:"message"`
+	Fields  map[string][]string `json:"-"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
+
+// UnmarshalJSON decodes the error message, which is a
+// string, a list of strings, or a map of field names to
+// messages for validation errors. Authentication errors
+// return the message in the error field.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Message json.RawMessage `json:"message"`
+		Error   string          `json:"error"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var list []string
+	switch {
+	case len(raw.Message) == 0, string(raw.Message) == "null":
+		e.Message = raw.Error
+	case json.Unmarshal(raw.Message, &e.Message) == nil:
+	case json.Unmarshal(raw.Message, &list) == nil:
+		e.Message = strings.Join(list, ", ")
+	case json.Unmarshal(raw.Message, &e.Fields) == nil:
+		for _, name := range sortedKeys(e.Fields) {
+			for _, message := range e.Fields[name] {
+				list = append(list, name+" "+message)
+			}
+		}
+		e.Message = strings.Join(list, ", ")
+	}
+	return nil
+}
+
+// helper function returns the field names of the
+// validation errors in sorted order.
+func sortedKeys(fields map[string][]string) []string {
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// helper function converts the gitlab error to the
+// common error type.
+func convertError(res *scm.Response, from *Error) *scm.Error {
+	to := &scm.Error{
+		Status:  res.Status,
+		Driver:  scm.DriverGitlab,
+		ID:      res.ID,
+		Message: from.Message,
+	}
+	for _, name := range sortedKeys(from.Fields) {
+		for _, message := range from.Fields[name] {
+			to.Fields = append(to.Fields, scm.FieldError{
+				Field:   name,
+				Message: message,
+			})
+		}
+	}
+	return to
+}
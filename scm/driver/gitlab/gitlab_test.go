@@ -5,9 +5,14 @@
 package gitlab
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 var mockHeaders = map[string]string{
@@ -60,6 +65,74 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/issues").
+		Reply(400).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error_validation.json")
+
+	client := NewDefault()
+	_, _, err := client.Issues.Create(context.Background(), "diaspora/diaspora", &scm.IssueInput{})
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+
+	want := new(scm.Error)
+	raw, _ := ioutil.ReadFile("testdata/error_validation.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestClient_PageHeaders(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/branches").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(map[string]string{"X-Page": "2", "X-Prev-Page": "1", "X-Total-Pages": "2"}).
+		File("testdata/branches.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/branches").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(map[string]string{"X-Page": "1", "X-Next-Page": "2", "X-Total-Pages": "2"}).
+		File("testdata/branches.json")
+
+	client := NewDefault()
+	pager := new(scm.Pager)
+	got := []*scm.Reference{}
+	err := pager.All(context.Background(), &got, func(ctx context.Context, opts scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.ListBranches(ctx, "diaspora/diaspora", opts)
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reference{}
+	raw, _ := ioutil.ReadFile("testdata/branches.json.golden")
+	json.Unmarshal(raw, &want)
+	want = append(want, want...)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func testRate(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Rate.Limit, 600; got != want {
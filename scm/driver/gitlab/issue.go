@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -47,12 +48,30 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	in := url.Values{}
-	in.Set("title", input.Title)
-	in.Set("description", input.Body)
-	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), in.Encode())
+	in, res, err := s.convertInput(ctx, input)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues", encode(repo))
 	out := new(issue)
-	res, err := s.client.do(ctx, "POST", path, nil, out)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertIssue(out), res, err
+}
+
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	in, res, err := s.convertInput(ctx, input)
+	if err != nil {
+		return nil, res, err
+	}
+	switch input.State {
+	case scm.IssueStateOpen:
+		in.StateEvent = "reopen"
+	case scm.IssueStateClosed:
+		in.StateEvent = "close"
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	out := new(issue)
+	res, err = s.client.do(ctx, "PUT", path, in, out)
 	return convertIssue(out), res, err
 }
 
@@ -76,6 +95,12 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?discussion_locked=true", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -88,6 +113,97 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return res, err
 }
 
+func (s *issueService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("add_labels", label)
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("remove_labels", label)
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// SetLabels replaces the issue labels. An empty list
+// removes all labels.
+func (s *issueService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("labels", strings.Join(labels, ","))
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// AddAssignees adds the users to the issue assignees. The
+// issue is fetched first, because GitLab replaces the
+// assignee list.
+func (s *issueService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, current, res, err := s.findUsers(ctx, repo, number, logins)
+	if err != nil {
+		return res, err
+	}
+	in := &assigneesInput{AssigneeIDs: appendUserIDs(current.Assignees, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+func (s *issueService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, current, res, err := s.findUsers(ctx, repo, number, logins)
+	if err != nil {
+		return res, err
+	}
+	in := &assigneesInput{AssigneeIDs: removeUserIDs(current.Assignees, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+// helper function returns the user ids for the logins, and
+// the issue with the current assignees.
+func (s *issueService) findUsers(ctx context.Context, repo string, number int, logins []string) ([]int, *issue, *scm.Response, error) {
+	users := &userService{s.client}
+	ids, res, err := users.findIDs(ctx, logins)
+	if err != nil {
+		return nil, nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	out := new(issue)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	return ids, out, res, err
+}
+
+// helper function returns the gitlab issue input. The
+// labels and assignees are only sent when set, so that an
+// empty list clears them. GitLab identifies assignees by
+// id, so the logins are resolved to user ids.
+func (s *issueService) convertInput(ctx context.Context, from *scm.IssueInput) (*issueInput, *scm.Response, error) {
+	to := &issueInput{
+		Title:       from.Title,
+		Description: from.Body,
+		MilestoneID: from.Milestone,
+	}
+	if from.Labels != nil {
+		labels := strings.Join(from.Labels, ",")
+		to.Labels = &labels
+	}
+	if from.Assignees != nil {
+		users := &userService{s.client}
+		ids, res, err := users.findIDs(ctx, from.Assignees)
+		if err != nil {
+			return nil, res, err
+		}
+		if ids == nil {
+			ids = []int{}
+		}
+		to.AssigneeIDs = &ids
+	}
+	return to, nil, nil
+}
+
+func (s *issueService) update(ctx context.Context, repo string, number int, in interface{}) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 type issue struct {
 	ID     int      `json:"id"`
 	Number int      `json:"iid"`
@@ -102,8 +218,19 @@ type issue struct {
 		Username string      `json:"username"`
 		Avatar   null.String `json:"avatar_url"`
 	} `json:"author"`
-	Created time.Time `json:"created_at"`
-	Updated time.Time `json:"updated_at"`
+	Assignees []*user    `json:"assignees"`
+	Milestone *milestone `json:"milestone"`
+	Created   time.Time  `json:"created_at"`
+	Updated   time.Time  `json:"updated_at"`
+}
+
+type issueInput struct {
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	StateEvent  string  `json:"state_event,omitempty"`
+	Labels      *string `json:"labels,omitempty"`
+	AssigneeIDs *[]int  `json:"assignee_ids,omitempty"`
+	MilestoneID *int    `json:"milestone_id,omitempty"`
 }
 
 type issueComment struct {
@@ -141,7 +268,7 @@ func convertIssue(from *issue) *scm.Issue {
 		Title:  from.Title,
 		Body:   from.Desc,
 		Link:   from.Link,
-		Labels: from.Labels,
+		Labels: convertLabelNames(from.Labels),
 		Locked: from.Locked,
 		Closed: from.State == "closed",
 		Author: scm.User{
@@ -149,8 +276,10 @@ func convertIssue(from *issue) *scm.Issue {
 			Login:  from.Author.Username,
 			Avatar: from.Author.Avatar.String,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.Created,
+		Updated:   from.Updated,
 	}
 }
 
@@ -0,0 +1,112 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+// dateLayout is the layout of GitLab milestone due dates,
+// which have no time component.
+const dateLayout = "2006-01-02"
+
+type milestoneService struct {
+	client *wrapper
+}
+
+type milestone struct {
+	ID          int         `json:"id"`
+	Number      int         `json:"iid"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	State       string      `json:"state"`
+	Link        string      `json:"web_url"`
+	DueDate     null.String `json:"due_date"`
+}
+
+type milestoneInput struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	DueDate     string `json:"due_date,omitempty"`
+	StateEvent  string `json:"state_event,omitempty"`
+}
+
+func (s *milestoneService) Find(ctx context.Context, repo string, id int) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/milestones/%d", encode(repo), id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/milestones?%s", encode(repo), encodeMilestoneListOptions(opts))
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMilestoneList(out), res, err
+}
+
+func (s *milestoneService) Create(ctx context.Context, repo string, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/milestones", encode(repo))
+	out := new(milestone)
+	res, err := s.client.do(ctx, "POST", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Update(ctx context.Context, repo string, id int, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/milestones/%d", encode(repo), id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "PUT", path, convertMilestoneInput(input), out)
+	return convertMilestone(out), res, err
+}
+
+func (s *milestoneService) Close(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/milestones/%d", encode(repo), id)
+	in := &milestoneInput{StateEvent: "close"}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func convertMilestoneInput(from *scm.MilestoneInput) *milestoneInput {
+	to := &milestoneInput{
+		Title:       from.Title,
+		Description: from.Description,
+	}
+	if !from.DueDate.IsZero() {
+		to.DueDate = from.DueDate.Format(dateLayout)
+	}
+	return to
+}
+
+func convertMilestoneList(from []*milestone) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+// helper function to convert from the gitlab milestone
+// structure to the common milestone structure. It returns
+// nil if the issue or merge request has no milestone.
+func convertMilestone(from *milestone) *scm.Milestone {
+	if from == nil {
+		return nil
+	}
+	to := &scm.Milestone{
+		ID:          from.ID,
+		Title:       from.Title,
+		Description: from.Description,
+		Link:        from.Link,
+		Closed:      from.State == "closed",
+	}
+	if due, err := time.Parse(dateLayout, from.DueDate.String); err == nil {
+		to.DueDate = due
+	}
+	return to
+}
@@ -8,11 +8,17 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// draftTitle matches the title prefixes that GitLab uses
+// to mark a merge request as a draft.
+var draftTitle = regexp.MustCompile(`(?i)^\s*(\[(draft|wip)\]|\((draft|wip)\)|(draft|wip):|draft\s+-)`)
+
 type pullService struct {
 	client *wrapper
 }
@@ -52,9 +58,16 @@ func (s *pullService) ListComments(ctx context.Context, repo string, index int,
 	return convertIssueCommentList(out), res, err
 }
 
+// Create creates the merge request. GitLab marks merge
+// requests as drafts by the title, so the title is prefixed
+// with Draft: when creating a draft.
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	title := input.Title
+	if input.Draft && !isDraftTitle(title) {
+		title = "Draft: " + title
+	}
 	in := url.Values{}
-	in.Set("title", input.Title)
+	in.Set("title", title)
 	in.Set("description", input.Body)
 	in.Set("source_branch", input.Source)
 	in.Set("target_branch", input.Target)
@@ -64,6 +77,29 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	in := url.Values{}
+	if input.Title != "" {
+		in.Set("title", input.Title)
+	}
+	if input.Body != "" {
+		in.Set("description", input.Body)
+	}
+	if input.Target != "" {
+		in.Set("target_branch", input.Target)
+	}
+	switch input.State {
+	case scm.PullRequestStateOpen:
+		in.Set("state_event", "reopen")
+	case scm.PullRequestStateClosed:
+		in.Set("state_event", "close")
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	out := new(pr)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertPullRequest(out), res, err
+}
+
 func (s *pullService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	in := url.Values{}
 	in.Set("body", input.Body)
@@ -79,9 +115,33 @@ func (s *pullService) DeleteComment(ctx context.Context, repo string, index, id
 	return res, err
 }
 
-func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+// Merge merges the merge request. The GitLab merge method
+// is a project setting, so only squashing can be selected
+// when merging.
+func (s *pullService) Merge(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input == nil {
+		input = new(scm.MergeInput)
+	}
+	in := &mergeInput{
+		Sha:                       input.Sha,
+		ShouldRemoveSourceBranch:  input.DeleteSourceBranch,
+		MergeWhenPipelineSucceeds: input.MergeWhenPipelineSucceeds,
+	}
+	message := input.Title
+	if input.Message != "" {
+		message = strings.TrimSpace(input.Title + "\n\n" + input.Message)
+	}
+	switch input.Method {
+	case scm.MergeMethodDefault, scm.MergeMethodMerge:
+		in.MergeCommitMessage = message
+	case scm.MergeMethodSquash:
+		in.Squash = true
+		in.SquashCommitMessage = message
+	default:
+		return nil, &scm.OptionError{Option: "Method"}
+	}
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/merge", encode(repo), number)
-	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
 	return res, err
 }
 
@@ -91,12 +151,128 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
+func (s *pullService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("add_labels", label)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *pullService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("remove_labels", label)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// SetLabels replaces the merge request labels. An empty
+// list removes all labels.
+func (s *pullService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("labels", strings.Join(labels, ","))
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// RequestReviewers adds the users to the merge request
+// reviewers. GitLab replaces the reviewer list, so the
+// merge request is fetched first and the users are added
+// to the current reviewers.
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	ids, current, res, err := s.findUsers(ctx, repo, number, input.Users)
+	if err != nil {
+		return res, err
+	}
+	in := &reviewersInput{ReviewerIDs: appendUserIDs(current.Reviewers, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	ids, current, res, err := s.findUsers(ctx, repo, number, input.Users)
+	if err != nil {
+		return res, err
+	}
+	in := &reviewersInput{ReviewerIDs: removeUserIDs(current.Reviewers, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+// AddAssignees adds the users to the merge request
+// assignees. Like reviewers, the assignee list is replaced,
+// so the users are added to the current assignees.
+func (s *pullService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, current, res, err := s.findUsers(ctx, repo, number, logins)
+	if err != nil {
+		return res, err
+	}
+	in := &assigneesInput{AssigneeIDs: appendUserIDs(current.Assignees, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+func (s *pullService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, current, res, err := s.findUsers(ctx, repo, number, logins)
+	if err != nil {
+		return res, err
+	}
+	in := &assigneesInput{AssigneeIDs: removeUserIDs(current.Assignees, ids)}
+	return s.update(ctx, repo, number, in)
+}
+
+// helper function returns the user ids for the logins, and
+// the merge request with the current reviewers and assignees.
+func (s *pullService) findUsers(ctx context.Context, repo string, number int, logins []string) ([]int, *pr, *scm.Response, error) {
+	users := &userService{s.client}
+	ids, res, err := users.findIDs(ctx, logins)
+	if err != nil {
+		return nil, nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(pr)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	return ids, out, res, err
+}
+
+func (s *pullService) update(ctx context.Context, repo string, number int, in interface{}) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type reviewersInput struct {
+	ReviewerIDs []int `json:"reviewer_ids"`
+}
+
+type assigneesInput struct {
+	AssigneeIDs []int `json:"assignee_ids"`
+}
+
+type mergeInput struct {
+	MergeCommitMessage        string `json:"merge_commit_message,omitempty"`
+	SquashCommitMessage       string `json:"squash_commit_message,omitempty"`
+	Squash                    bool   `json:"squash,omitempty"`
+	Sha                       string `json:"sha,omitempty"`
+	ShouldRemoveSourceBranch  bool   `json:"should_remove_source_branch,omitempty"`
+	MergeWhenPipelineSucceeds bool   `json:"merge_when_pipeline_succeeds,omitempty"`
+}
+
 type pr struct {
 	Number int    `json:"iid"`
 	Sha    string `json:"sha"`
 	Title  string `json:"title"`
 	Desc   string `json:"description"`
 	State  string `json:"state"`
+	Draft  bool   `json:"draft"`
+	WIP    bool   `json:"work_in_progress"`
 	Link   string `json:"web_url"`
 	Author struct {
 		Username string `json:"username"`
@@ -109,7 +285,10 @@ type pr struct {
 	Created      time.Time `json:"created_at"`
 	Updated      time.Time `json:"updated_at"`
 	Closed       time.Time
-	Labels       []string `json:"labels"`
+	Labels       []string   `json:"labels"`
+	Reviewers    []*user    `json:"reviewers"`
+	Assignees    []*user    `json:"assignees"`
+	Milestone    *milestone `json:"milestone"`
 }
 
 type changes struct {
@@ -122,6 +301,7 @@ type change struct {
 	Added   bool   `json:"new_file"`
 	Renamed bool   `json:"renamed_file"`
 	Deleted bool   `json:"deleted_file"`
+	Diff    string `json:"diff"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -133,12 +313,6 @@ func convertPullRequestList(from []*pr) []*scm.PullRequest {
 }
 
 func convertPullRequest(from *pr) *scm.PullRequest {
-	var labels []scm.Label
-	for _, label := range from.Labels {
-		labels = append(labels, scm.Label{
-			Name: label,
-		})
-	}
 	return &scm.PullRequest{
 		Number: from.Number,
 		Title:  from.Title,
@@ -150,17 +324,28 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Link:   from.Link,
 		Closed: from.State != "opened",
 		Merged: from.State == "merged",
+		Draft:  from.Draft || from.WIP || isDraftTitle(from.Title),
 		Author: scm.User{
 			Name:   from.Author.Name,
 			Login:  from.Author.Username,
 			Avatar: from.Author.Avatar,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
-		Labels:  labels,
+		Created:   from.Created,
+		Updated:   from.Updated,
+		Labels:    convertLabelNames(from.Labels),
+		Reviewers: convertUserList(from.Reviewers),
+		Assignees: convertUserList(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
 	}
 }
 
+// helper function returns true if the title marks the
+// merge request as a draft. GitLab accepts Draft and WIP
+// prefixes, in brackets, in parentheses or with a colon.
+func isDraftTitle(title string) bool {
+	return draftTitle.MatchString(title)
+}
+
 func convertChangeList(from []*change) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
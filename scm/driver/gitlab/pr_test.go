@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -119,7 +120,7 @@ func TestPullMerge(t *testing.T) {
 		SetHeaders(mockHeaders)
 
 	client := NewDefault()
-	res, err := client.PullRequests.Merge(context.Background(), "diaspora/diaspora", 1347)
+	res, err := client.PullRequests.Merge(context.Background(), "diaspora/diaspora", 1347, nil)
 	if err != nil {
 		t.Error(err)
 		return
@@ -129,6 +130,52 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMerge_Options(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/merge").
+		JSON(map[string]interface{}{
+			"squash_commit_message":        "Add feature\n\nDetails",
+			"squash":                       true,
+			"sha":                          "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+			"should_remove_source_branch":  true,
+			"merge_when_pipeline_succeeds": true,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.MergeInput{
+		Method:                    scm.MergeMethodSquash,
+		Title:                     "Add feature",
+		Message:                   "Details",
+		Sha:                       "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		DeleteSourceBranch:        true,
+		MergeWhenPipelineSucceeds: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.Merge(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMerge_NotSupported(t *testing.T) {
+	for _, method := range []scm.MergeMethod{scm.MergeMethodRebase, scm.MergeMethodFastForward} {
+		input := &scm.MergeInput{Method: method}
+		_, err := NewDefault().PullRequests.Merge(context.Background(), "diaspora/diaspora", 1347, input)
+		if !errors.Is(err, scm.ErrNotSupported) {
+			t.Errorf("Expect Not Supported error for %s, got %v", method, err)
+		}
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -150,6 +197,118 @@ func TestPullClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("state_event", "reopen").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.Reopen(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1").
+		MatchParam("title", "Draft: JS fix").
+		MatchParam("target_branch", "develop").
+		MatchParam("state_event", "reopen").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_update.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Title:  "Draft: JS fix",
+		Target: "develop",
+		State:  scm.PullRequestStateOpen,
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Update(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/merge_update.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullCreate_Draft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests").
+		MatchParam("title", "Draft: JS fix").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_update.json")
+
+	input := &scm.PullRequestInput{
+		Title:  "JS fix",
+		Source: "fix",
+		Target: "develop",
+		Draft:  true,
+	}
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.Create(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Draft {
+		t.Errorf("Want draft merge request")
+	}
+}
+
+func TestPullDraftTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		draft bool
+	}{
+		{"Draft: JS fix", true},
+		{"draft: JS fix", true},
+		{"[Draft] JS fix", true},
+		{"(Draft) JS fix", true},
+		{"Draft - JS fix", true},
+		{"WIP: JS fix", true},
+		{"[WIP] JS fix", true},
+		{"JS fix", false},
+		{"Drafting the release notes", false},
+		{"JS fix (WIP)", false},
+	}
+	for _, test := range tests {
+		if got := isDraftTitle(test.title); got != test.draft {
+			t.Errorf("Want draft %v for title %q, got %v", test.draft, test.title, got)
+		}
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -309,3 +468,121 @@ func TestPullCommentDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string][]int{"reviewer_ids": {2, 1}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"john_smith"},
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.RequestReviewers(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRequestReviewers_Teams(t *testing.T) {
+	input := &scm.ReviewerInput{
+		Teams: []string{"developers"},
+	}
+
+	client := NewDefault()
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "diaspora/diaspora", 1347, input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullRemoveAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string][]int{"assignee_ids": {}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.RemoveAssignees(context.Background(), "diaspora/diaspora", 1347, []string{"john_smith"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullSetLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("labels", "bug,feature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	res, err := client.PullRequests.SetLabels(context.Background(), "diaspora/diaspora", 1347, []string{"bug", "feature"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,220 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// releaseService implements the release service. GitLab
+// identifies releases by tag name, so the operations that
+// require a numeric release id are not supported, and
+// assets are attached to the release named by the input
+// Tag.
+type releaseService struct {
+	client *wrapper
+}
+
+type release struct {
+	Title       string    `json:"name"`
+	Description string    `json:"description"`
+	Tag         string    `json:"tag_name"`
+	Upcoming    bool      `json:"upcoming_release"`
+	Created     time.Time `json:"created_at"`
+	Released    time.Time `json:"released_at"`
+	Commit      struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"_links"`
+}
+
+type upload struct {
+	URL      string `json:"url"`
+	FullPath string `json:"full_path"`
+}
+
+type releaseLink struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type releaseLinkInput struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	LinkType string `json:"link_type"`
+}
+
+type releaseInput struct {
+	Title       string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Tag         string `json:"tag_name,omitempty"`
+	Ref         string `json:"ref,omitempty"`
+}
+
+func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) FindByTag(ctx context.Context, repo, tag string) (*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s", encode(repo), encodePath(tag))
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/releases?%s", encode(repo), encodeListOptions(opts))
+	out := []*release{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseList(out), res, err
+}
+
+// Create creates the release. GitLab has no draft releases,
+// and upcoming releases are derived from the release date,
+// so the draft and prerelease flags cannot be set.
+func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	if err := checkReleaseInput(input); err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/releases", encode(repo))
+	in := &releaseInput{
+		Title:       input.Title,
+		Description: input.Description,
+		Tag:         input.Tag,
+		Ref:         input.Commitish,
+	}
+	out := new(release)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UpdateByTag(ctx context.Context, repo, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	if err := checkReleaseInput(input); err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s", encode(repo), encodePath(tag))
+	in := &releaseInput{
+		Title:       input.Title,
+		Description: input.Description,
+	}
+	out := new(release)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRelease(out), res, err
+}
+
+func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DeleteByTag(ctx context.Context, repo, tag string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s", encode(repo), encodePath(tag))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// UploadAsset uploads the file to the project and links it
+// to the release named by the input Tag. The release id is
+// ignored.
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, id int, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	if input.Tag == "" {
+		return nil, nil, &scm.OptionError{Option: "Tag"}
+	}
+	buf := new(bytes.Buffer)
+	form := multipart.NewWriter(buf)
+	part, err := form.CreateFormFile("file", input.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	size, err := io.Copy(part, input.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/uploads", encode(repo))
+	in := &rawBody{
+		contentType: form.FormDataContentType(),
+		data:        buf,
+	}
+	file := new(upload)
+	res, err := s.client.do(ctx, "POST", path, in, file)
+	if err != nil {
+		return nil, res, err
+	}
+	// older versions of gitlab do not return the full path,
+	// in which case the upload path is relative to the
+	// project web address. The path is resolved relative to
+	// the base address, which may include a path prefix.
+	link := strings.TrimPrefix(file.FullPath, "/")
+	if link == "" {
+		link = repo + file.URL
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/releases/%s/assets/links", encode(repo), encodePath(input.Tag))
+	out := new(releaseLink)
+	res, err = s.client.do(ctx, "POST", path, &releaseLinkInput{
+		Name:     input.Name,
+		URL:      s.client.BaseURL.ResolveReference(&url.URL{Path: link}).String(),
+		LinkType: "other",
+	}, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.ReleaseAsset{
+		ID:          out.ID,
+		Name:        out.Name,
+		Link:        out.URL,
+		Size:        size,
+		ContentType: input.ContentType,
+	}, res, nil
+}
+
+// helper function returns an option error if the release
+// input sets the draft or prerelease flag.
+func checkReleaseInput(in *scm.ReleaseInput) error {
+	switch {
+	case in.Draft != nil && *in.Draft:
+		return &scm.OptionError{Option: "Draft"}
+	case in.Prerelease != nil && *in.Prerelease:
+		return &scm.OptionError{Option: "Prerelease"}
+	}
+	return nil
+}
+
+func convertReleaseList(from []*release) []*scm.Release {
+	to := []*scm.Release{}
+	for _, v := range from {
+		to = append(to, convertRelease(v))
+	}
+	return to
+}
+
+func convertRelease(from *release) *scm.Release {
+	return &scm.Release{
+		Title:       from.Title,
+		Description: from.Description,
+		Link:        from.Links.Self,
+		Tag:         from.Tag,
+		Commitish:   from.Commit.ID,
+		Prerelease:  from.Upcoming,
+		Created:     from.Created,
+		Published:   from.Released,
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.Find(context.Background(), "diaspora/diaspora", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/releases/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.FindByTag(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/releases").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/releases.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.List(context.Background(), "diaspora/diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReleaseCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/releases").
+		File("testdata/release_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "master",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.Create(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUpdateByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/releases/v1.0.0").
+		JSON(map[string]string{
+			"name":        "v1.0.0",
+			"description": "Description of the release",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Title:       "v1.0.0",
+		Description: "Description of the release",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UpdateByTag(context.Background(), "diaspora/diaspora", "v1.0.0", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUpdateByTag_Partial(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/releases/v1.0.0").
+		JSON(map[string]string{
+			"description": "Description of the release",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	in := &scm.ReleaseInput{
+		Description: "Description of the release",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Releases.UpdateByTag(context.Background(), "diaspora/diaspora", "v1.0.0", in)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReleaseDeleteByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/releases/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	res, err := client.Releases.DeleteByTag(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/uploads").
+		MatchHeader("Content-Type", "multipart/form-data").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/upload.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/releases/v1.0.0/assets/links").
+		JSON(map[string]string{
+			"name":      "example.txt",
+			"url":       "https://gitlab.com/diaspora/diaspora/uploads/66dbcd21ec5d24ed6ea225176098d52b/example.txt",
+			"link_type": "other",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_link.json")
+
+	in := &scm.ReleaseAssetInput{
+		Name:        "example.txt",
+		ContentType: "text/plain",
+		Data:        strings.NewReader("hello world"),
+		Tag:         "v1.0.0",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UploadAsset(context.Background(), "diaspora/diaspora", 0, in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/release_link.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUploadAsset_NoTag(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.UploadAsset(context.Background(), "diaspora/diaspora", 1, &scm.ReleaseAssetInput{})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Tag" {
+		t.Errorf("Want Tag OptionError, got %v", err)
+	}
+}
+
+func TestReleaseCreate_Draft(t *testing.T) {
+	draft := true
+	client := NewDefault()
+	_, _, err := client.Releases.Create(context.Background(), "diaspora/diaspora", &scm.ReleaseInput{Tag: "v1.0.0", Draft: &draft})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Draft" {
+		t.Errorf("Want Draft OptionError, got %v", err)
+	}
+}
+
+func TestReleaseCreate_Prerelease(t *testing.T) {
+	prerelease := true
+	client := NewDefault()
+	_, _, err := client.Releases.Create(context.Background(), "diaspora/diaspora", &scm.ReleaseInput{Tag: "v1.0.0", Prerelease: &prerelease})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Prerelease" {
+		t.Errorf("Want Prerelease OptionError, got %v", err)
+	}
+}
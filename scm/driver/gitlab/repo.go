@@ -61,6 +61,20 @@ type hook struct {
 	CreatedAt             time.Time `json:"created_at"`
 }
 
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	CanPush bool      `json:"can_push"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title   string `json:"title"`
+	Key     string `json:"key"`
+	CanPush bool   `json:"can_push"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -79,6 +93,13 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+func (s *repositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
 	out := new(repository)
@@ -100,6 +121,13 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm
 	return convertHookList(out), res, err
 }
 
+func (s *repositoryService) ListKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys?%s", encode(repo), encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
 func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/statuses?%s", encode(repo), ref, encodeListOptions(opts))
 	out := []*status{}
@@ -142,6 +170,18 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+func (s *repositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys", encode(repo))
+	in := &keyInput{
+		Title:   input.Title,
+		Key:     input.Key,
+		CanPush: !input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	params := url.Values{}
 	params.Set("state", convertFromState(input.State))
@@ -162,6 +202,11 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) DeleteKey(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -219,6 +264,24 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(from []*key) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: !from.CanPush,
+		Created:  from.Created,
+	}
+}
+
 type status struct {
 	Name    string      `json:"name"`
 	Desc    null.String `json:"description"`
@@ -6,6 +6,10 @@ package gitlab
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +19,321 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d", encode(repo), number, id)
+	out := new(note)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertNote(out), res, err
 }
 
+// List returns the merge request diff notes. The merge
+// request discussions are paginated, so a page may contain
+// fewer review comments than the page size.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+	out := []*discussion{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDiscussionList(out), res, err
 }
 
+// Create starts a merge request discussion positioned on
+// the line of the latest merge request diff. The merge
+// request changes are fetched first, because GitLab needs
+// the diff commits, and both line numbers when the line is
+// unchanged.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes", encode(repo), number)
+	changes := new(mergeChanges)
+	res, err := s.client.do(ctx, "GET", path, nil, changes)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &discussionInput{
+		Body:     input.Body,
+		Position: convertFromReviewInput(input, changes),
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions", encode(repo), number)
+	out := new(discussion)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Notes) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertNote(out.Notes[0]), res, nil
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d", encode(repo), number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ListSummaries returns the merge request approvals. GitLab
+// does not paginate approvals, so the list options are
+// ignored.
+func (s *reviewService) ListSummaries(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSummary, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertApprovals(out), res, err
+}
+
+// Submit approves or comments on the merge request. The
+// review body is added as a merge request note. GitLab has
+// no review state that requests changes.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSummaryInput) (*scm.ReviewSummary, *scm.Response, error) {
+	switch input.State {
+	case scm.ReviewStateApproved, scm.ReviewStateCommented:
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	if input.State == scm.ReviewStateCommented && input.Body == "" && len(input.Comments) == 0 {
+		return nil, nil, &scm.OptionError{Option: "Body"}
+	}
+	var res *scm.Response
+	var err error
+	for _, comment := range input.Comments {
+		if _, res, err = s.Create(ctx, repo, number, comment); err != nil {
+			return nil, res, err
+		}
+	}
+	if input.Body != "" {
+		pulls := &pullService{s.client}
+		_, res, err = pulls.CreateComment(ctx, repo, number, &scm.CommentInput{Body: input.Body})
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if input.State == scm.ReviewStateApproved {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approve", encode(repo), number)
+		in := &approveInput{Sha: input.Sha}
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+	}
+	return &scm.ReviewSummary{
+		State: input.State,
+		Body:  input.Body,
+		Sha:   input.Sha,
+	}, res, err
+}
+
+// Dismiss removes the merge request approval of the
+// authenticated user. The review id and message are
+// ignored.
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/unapprove", encode(repo), number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// FindApprovals returns the merge request approval status,
+// including the approvals required by the approval rules.
+func (s *reviewService) FindApprovals(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertApprovalStatus(out), res, err
+}
+
+type discussion struct {
+	ID    string  `json:"id"`
+	Notes []*note `json:"notes"`
+}
+
+type note struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	Body      string    `json:"body"`
+	Author    user      `json:"author"`
+	Position  *position `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type position struct {
+	BaseSha      string `json:"base_sha"`
+	StartSha     string `json:"start_sha"`
+	HeadSha      string `json:"head_sha"`
+	OldPath      string `json:"old_path"`
+	NewPath      string `json:"new_path"`
+	PositionType string `json:"position_type"`
+	OldLine      int    `json:"old_line,omitempty"`
+	NewLine      int    `json:"new_line,omitempty"`
+}
+
+type discussionInput struct {
+	Body     string    `json:"body"`
+	Position *position `json:"position"`
+}
+
+type mergeChanges struct {
+	DiffRefs struct {
+		BaseSha  string `json:"base_sha"`
+		HeadSha  string `json:"head_sha"`
+		StartSha string `json:"start_sha"`
+	} `json:"diff_refs"`
+	Changes []*change `json:"changes"`
+}
+
+type approvals struct {
+	Approved          bool `json:"approved"`
+	ApprovalsRequired int  `json:"approvals_required"`
+	ApprovalsLeft     int  `json:"approvals_left"`
+	ApprovedBy        []struct {
+		User user `json:"user"`
+	} `json:"approved_by"`
+}
+
+type approveInput struct {
+	Sha string `json:"sha,omitempty"`
+}
+
+func convertApprovalStatus(from *approvals) *scm.Approvals {
+	to := &scm.Approvals{
+		Required:  from.ApprovalsRequired,
+		Left:      from.ApprovalsLeft,
+		Approved:  from.Approved,
+		Approvers: []scm.User{},
+	}
+	for _, v := range from.ApprovedBy {
+		to.Approvers = append(to.Approvers, *convertUser(&v.User))
+	}
+	return to
+}
+
+func convertApprovals(from *approvals) []*scm.ReviewSummary {
+	to := []*scm.ReviewSummary{}
+	for _, v := range from.ApprovedBy {
+		to = append(to, &scm.ReviewSummary{
+			State:  scm.ReviewStateApproved,
+			Author: *convertUser(&v.User),
+		})
+	}
+	return to
+}
+
+// helper function returns the diff notes in the merge
+// request discussions, including replies.
+func convertDiscussionList(from []*discussion) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		for _, n := range v.Notes {
+			if n.Position == nil {
+				continue
+			}
+			to = append(to, convertNote(n))
+		}
+	}
+	return to
+}
+
+func convertNote(from *note) *scm.Review {
+	to := &scm.Review{
+		ID:   from.ID,
+		Body: from.Body,
+		Author: scm.User{
+			Login:  from.Author.Username,
+			Name:   from.Author.Name,
+			Avatar: from.Author.Avatar,
+		},
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+	if p := from.Position; p != nil {
+		to.Sha = p.HeadSha
+		to.Path, to.Line = p.NewPath, p.NewLine
+		// comments on removed lines only have an old line
+		// number and an old path.
+		if p.NewLine == 0 {
+			to.Path, to.Line = p.OldPath, p.OldLine
+			to.Side = scm.ReviewSideOld
+		}
+	}
+	return to
+}
+
+// helper function returns the discussion position for the
+// review comment. The paths are taken from the file change,
+// so comments on renamed files are positioned correctly.
+func convertFromReviewInput(from *scm.ReviewInput, changes *mergeChanges) *position {
+	to := &position{
+		BaseSha:      changes.DiffRefs.BaseSha,
+		StartSha:     changes.DiffRefs.StartSha,
+		HeadSha:      changes.DiffRefs.HeadSha,
+		OldPath:      from.Path,
+		NewPath:      from.Path,
+		PositionType: "text",
+	}
+	var diff string
+	for _, c := range changes.Changes {
+		if (from.Side == scm.ReviewSideOld && c.OldPath == from.Path) ||
+			(from.Side == scm.ReviewSideNew && c.NewPath == from.Path) {
+			to.OldPath, to.NewPath, diff = c.OldPath, c.NewPath, c.Diff
+			break
+		}
+	}
+	to.OldLine, to.NewLine = diffLines(diff, from.Line, from.Side)
+	return to
+}
+
+// helper function returns the old and new line numbers of
+// the line on the side of the unified diff. Added lines
+// only have a new line number, removed lines only have an
+// old line number, and unchanged lines have both.
+func diffLines(diff string, line int, side scm.ReviewSide) (int, int) {
+	var o, n, offset int
+	for _, text := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(text, "@@") {
+			oldStart, newStart := parseHunkHeader(text)
+			// the line is unchanged and before the hunk.
+			if side == scm.ReviewSideNew && line < newStart {
+				return line - offset, line
+			}
+			if side == scm.ReviewSideOld && line < oldStart {
+				return line, line + offset
+			}
+			o, n = oldStart, newStart
+			continue
+		}
+		if o == 0 && n == 0 {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(text, "+"):
+			if side == scm.ReviewSideNew && n == line {
+				return 0, n
+			}
+			n++
+		case strings.HasPrefix(text, "-"):
+			if side == scm.ReviewSideOld && o == line {
+				return o, 0
+			}
+			o++
+		case strings.HasPrefix(text, " "):
+			if (side == scm.ReviewSideNew && n == line) ||
+				(side == scm.ReviewSideOld && o == line) {
+				return o, n
+			}
+			o++
+			n++
+		}
+		offset = n - o
+	}
+	// the line is unchanged and after the last hunk.
+	if side == scm.ReviewSideOld {
+		return line, line + offset
+	}
+	return line - offset, line
+}
+
+// helper function returns the old and new start lines of
+// the unified diff hunk header.
+func parseHunkHeader(header string) (int, int) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 {
+		return 0, 0
+	}
+	oldRange := strings.SplitN(strings.TrimPrefix(fields[1], "-"), ",", 2)
+	newRange := strings.SplitN(strings.TrimPrefix(fields[2], "+"), ",", 2)
+	oldStart, _ := strconv.Atoi(oldRange[0])
+	newStart, _ := strconv.Atoi(newRange[0])
+	return oldStart, newStart
 }
@@ -6,39 +6,340 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Find(context.Background(), "diaspora/diaspora", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/notes/1128").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_diff_note.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Find(context.Background(), "diaspora/diaspora", 1, 1128)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_diff_note.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
 
 func TestReviewList(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merge_discussions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/merge_diff_notes.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
 }
 
 func TestReviewCreate(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Create(context.Background(), "diaspora/diaspora", 1, nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/changes").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_changes.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		JSON(map[string]interface{}{
+			"body": "Please use a constant here.",
+			"position": map[string]interface{}{
+				"base_sha":      "b5d6e7b1613fca24d250fa8e5bc7bcc3dd6002ef",
+				"start_sha":     "7c9c2ead8a320fb7ba0b4e234bd9529a2614e306",
+				"head_sha":      "4803c71e6b1833ca72b8b26ef2ecd5adc8a38031",
+				"old_path":      "README.md",
+				"new_path":      "README.md",
+				"position_type": "text",
+				"old_line":      12,
+				"new_line":      12,
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	input := &scm.ReviewInput{
+		Body: "Please use a constant here.",
+		Path: "README.md",
+		Line: 12,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_diff_note.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
 
 func TestReviewDelete(t *testing.T) {
-	service := new(reviewService)
-	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/merge_requests/1/notes/1128").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.Delete(context.Background(), "diaspora/diaspora", 1, 1128)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewPosition(t *testing.T) {
+	changes := new(mergeChanges)
+	raw, _ := ioutil.ReadFile("testdata/merge_changes.json")
+	json.Unmarshal(raw, changes)
+
+	tests := []struct {
+		path    string
+		line    int
+		side    scm.ReviewSide
+		oldPath string
+		newPath string
+		oldLine int
+		newLine int
+	}{
+		// unchanged lines before, in and after the hunk
+		{"README.md", 3, scm.ReviewSideNew, "README.md", "README.md", 3, 3},
+		{"README.md", 10, scm.ReviewSideNew, "README.md", "README.md", 10, 10},
+		{"README.md", 30, scm.ReviewSideOld, "README.md", "README.md", 30, 30},
+		// added and removed lines
+		{"README.md", 11, scm.ReviewSideNew, "README.md", "README.md", 0, 11},
+		{"README.md", 11, scm.ReviewSideOld, "README.md", "README.md", 11, 0},
+		// renamed file with a line added, so unchanged lines
+		// after the addition are offset.
+		{"docs/new.md", 2, scm.ReviewSideNew, "docs/old.md", "docs/new.md", 0, 2},
+		{"docs/new.md", 3, scm.ReviewSideNew, "docs/old.md", "docs/new.md", 2, 3},
+		{"docs/new.md", 10, scm.ReviewSideNew, "docs/old.md", "docs/new.md", 9, 10},
+		{"docs/old.md", 9, scm.ReviewSideOld, "docs/old.md", "docs/new.md", 9, 10},
+	}
+	for _, test := range tests {
+		input := &scm.ReviewInput{Path: test.path, Line: test.line, Side: test.side}
+		got := convertFromReviewInput(input, changes)
+		if got.OldPath != test.oldPath || got.NewPath != test.newPath {
+			t.Errorf("Want paths %s and %s for %s, got %s and %s", test.oldPath, test.newPath, test.path, got.OldPath, got.NewPath)
+		}
+		if got.OldLine != test.oldLine || got.NewLine != test.newLine {
+			t.Errorf("Want lines %d and %d for %s line %d, got %d and %d", test.oldLine, test.newLine, test.path, test.line, got.OldLine, got.NewLine)
+		}
+	}
+}
+
+func TestReviewListSummaries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListSummaries(context.Background(), "diaspora/diaspora", 5, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/approvals.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewFindApprovals(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.FindApprovals(context.Background(), "diaspora/diaspora", 5)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Approvals)
+	raw, _ := ioutil.ReadFile("testdata/approvals_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/5/notes").
+		MatchParam("body", "lgtm").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_note.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/5/approve").
+		JSON(map[string]string{"sha": "6104942438c14ec7bd21c6cd5bd995272b3faff6"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateApproved,
+		Body:  "lgtm",
+		Sha:   "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Submit(context.Background(), "diaspora/diaspora", 5, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.ReviewSummary{
+		State: scm.ReviewStateApproved,
+		Body:  "lgtm",
+		Sha:   "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewSubmit_ChangesRequested(t *testing.T) {
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateChangesRequested,
+	}
+	_, _, err := NewDefault().Reviews.Submit(context.Background(), "diaspora/diaspora", 5, input)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewSubmit_Empty(t *testing.T) {
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateCommented,
+	}
+	_, _, err := NewDefault().Reviews.Submit(context.Background(), "diaspora/diaspora", 5, input)
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Body" {
+		t.Errorf("Want Body OptionError, got %v", err)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/5/unapprove").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.Dismiss(context.Background(), "diaspora/diaspora", 5, 0, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
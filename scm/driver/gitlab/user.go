@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -36,12 +37,35 @@ func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *
 	return convertUser(out[0]), res, err
 }
 
+// helper function returns the user ids for the logins.
+// GitLab assigns merge requests and issues by user id,
+// so each login is looked up by username.
+func (s *userService) findIDs(ctx context.Context, logins []string) ([]int, *scm.Response, error) {
+	var ids []int
+	var res *scm.Response
+	for _, login := range logins {
+		path := fmt.Sprintf("api/v4/users?username=%s", url.QueryEscape(login))
+		out := []*user{}
+		var err error
+		res, err = s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		if len(out) != 1 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, out[0].ID)
+	}
+	return ids, res, nil
+}
+
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
 	user, res, err := s.Find(ctx)
 	return user.Email, res, err
 }
 
 type user struct {
+	ID       int         `json:"id"`
 	Username string      `json:"username"`
 	Name     string      `json:"name"`
 	Email    null.String `json:"email"`
@@ -56,3 +80,50 @@ func convertUser(from *user) *scm.User {
 		Name:   from.Name,
 	}
 }
+
+// helper function returns the users in the list, or nil
+// if the list is empty.
+func convertUserList(from []*user) []scm.User {
+	var to []scm.User
+	for _, v := range from {
+		to = append(to, *convertUser(v))
+	}
+	return to
+}
+
+// helper function returns the ids of the users, with the
+// ids appended. Ids already in the list are skipped.
+func appendUserIDs(from []*user, ids []int) []int {
+	to := []int{}
+	seen := map[int]bool{}
+	for _, v := range from {
+		to = append(to, v.ID)
+		seen[v.ID] = true
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			to = append(to, id)
+			seen[id] = true
+		}
+	}
+	return to
+}
+
+// helper function returns the ids of the users, with the
+// ids removed.
+func removeUserIDs(from []*user, ids []int) []int {
+	to := []int{}
+	for _, v := range from {
+		removed := false
+		for _, id := range ids {
+			if v.ID == id {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			to = append(to, v.ID)
+		}
+	}
+	return to
+}
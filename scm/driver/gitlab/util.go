@@ -79,6 +79,23 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	return params.Encode()
 }
 
+func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	// GitLab returns all milestones when the state is omitted.
+	if opts.Closed && !opts.Open {
+		params.Set("state", "closed")
+	} else if opts.Open && !opts.Closed {
+		params.Set("state", "active")
+	}
+	return params.Encode()
+}
+
 func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -96,3 +113,17 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	return params.Encode()
+}
@@ -133,3 +133,16 @@ func Test_encodePullRequestListOptions_Closed(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodeMilestoneListOptions(t *testing.T) {
+	opts := scm.MilestoneListOptions{
+		Page: 10,
+		Size: 30,
+		Open: true,
+	}
+	want := "page=10&per_page=30&state=active"
+	got := encodeMilestoneListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded milestone list options %q, got %q", want, got)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -31,10 +32,14 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	switch req.Header.Get("X-Gitlab-Event") {
 	case "Push Hook", "Tag Push Hook":
 		hook, err = parsePushHook(data)
-	case "Issue Hook":
-		return nil, scm.ErrUnknownEvent
+	case "Issue Hook", "Confidential Issue Hook":
+		hook, err = parseIssueHook(data)
+	case "Note Hook", "Confidential Note Hook":
+		hook, err = parseCommentHook(data)
 	case "Merge Request Hook":
 		hook, err = parsePullRequestHook(data)
+	case "Pipeline Hook":
+		hook, err = parsePipelineHook(data)
 	default:
 		return nil, scm.ErrUnknownEvent
 	}
@@ -103,6 +108,53 @@ func parsePullRequestHook(data []byte) (scm.Webhook, error) {
 	}
 }
 
+func parseIssueHook(data []byte) (scm.Webhook, error) {
+	src := new(issueHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	switch src.ObjectAttributes.Action {
+	case "open", "close", "reopen", "update":
+		// no-op
+	default:
+		return nil, scm.ErrUnknownEvent
+	}
+	return convertIssueHook(src), nil
+}
+
+func parseCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(commentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	switch src.ObjectAttributes.NoteableType {
+	case "Issue":
+		return convertIssueCommentHook(src), nil
+	case "MergeRequest":
+		if src.ObjectAttributes.Type == "DiffNote" {
+			return convertReviewCommentHook(src), nil
+		}
+		return convertPullRequestCommentHook(src), nil
+	case "Commit":
+		return convertCommitCommentHook(src), nil
+	default:
+		// comments on snippets cannot be represented
+		// by the common webhook types.
+		return nil, scm.ErrUnknownEvent
+	}
+}
+
+func parsePipelineHook(data []byte) (scm.Webhook, error) {
+	src := new(pipelineHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertPipelineHook(src), nil
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
 	var commits []scm.Commit
 	for _, c := range src.Commits {
@@ -266,6 +318,7 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 			Link:   src.ObjectAttributes.URL,
 			Closed: src.ObjectAttributes.State != "opened",
 			Merged: src.ObjectAttributes.State == "merged",
+			Draft:  src.ObjectAttributes.WorkInProgress || isDraftTitle(src.ObjectAttributes.Title),
 			// Created   : src.ObjectAttributes.CreatedAt,
 			// Updated  : src.ObjectAttributes.UpdatedAt, // 2017-12-10 17:01:11 UTC
 			Author: scm.User{
@@ -294,6 +347,344 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertIssueHook(src *issueHook) *scm.IssueHook {
+	action := scm.ActionUpdate
+	switch src.ObjectAttributes.Action {
+	case "open":
+		action = scm.ActionOpen
+	case "close":
+		action = scm.ActionClose
+	case "reopen":
+		action = scm.ActionReopen
+	case "update":
+		// gitlab does not send a dedicated label event.
+		// Instead we compare the previous and current
+		// labels to determine if labels were changed.
+		previous := len(src.Changes.Labels.Previous)
+		current := len(src.Changes.Labels.Current)
+		switch {
+		case current > previous:
+			action = scm.ActionLabel
+		case current < previous:
+			action = scm.ActionUnlabel
+		}
+	}
+	var labels []scm.Label
+	for _, label := range src.Labels {
+		labels = append(labels, scm.Label{
+			Name:        label.Title,
+			Color:       convertColor(label.Color),
+			Description: label.Description,
+		})
+	}
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	return &scm.IssueHook{
+		Action: action,
+		Issue: scm.Issue{
+			Number: src.ObjectAttributes.Iid,
+			Title:  src.ObjectAttributes.Title,
+			Body:   src.ObjectAttributes.Description,
+			Link:   src.ObjectAttributes.URL,
+			Labels: labels,
+			Closed: src.ObjectAttributes.State == "closed",
+			Author: scm.User{
+				Login:  src.User.Username,
+				Name:   src.User.Name,
+				Avatar: src.User.AvatarURL,
+			},
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+func convertIssueCommentHook(src *commentHook) *scm.IssueCommentHook {
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	return &scm.IssueCommentHook{
+		Action: convertCommentAction(src.ObjectAttributes.Action),
+		Issue: scm.Issue{
+			Number: src.Issue.Iid,
+			Title:  src.Issue.Title,
+			Body:   src.Issue.Description,
+			Link:   src.Issue.URL,
+			Closed: src.Issue.State == "closed",
+			// NOTE the note hook does not include the
+			// issue author, only the author identifier.
+			Created: parseTimeString(src.Issue.CreatedAt),
+			Updated: parseTimeString(src.Issue.UpdatedAt),
+		},
+		Comment: scm.Comment{
+			ID:   src.ObjectAttributes.ID,
+			Body: src.ObjectAttributes.Note,
+			Author: scm.User{
+				Login:  src.User.Username,
+				Name:   src.User.Name,
+				Avatar: src.User.AvatarURL,
+			},
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+func convertPullRequestCommentHook(src *commentHook) *scm.PullRequestCommentHook {
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	return &scm.PullRequestCommentHook{
+		Action:      convertCommentAction(src.ObjectAttributes.Action),
+		PullRequest: convertCommentPullRequest(src),
+		Comment: scm.Comment{
+			ID:   src.ObjectAttributes.ID,
+			Body: src.ObjectAttributes.Note,
+			Author: scm.User{
+				Login:  src.User.Username,
+				Name:   src.User.Name,
+				Avatar: src.User.AvatarURL,
+			},
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+func convertCommitCommentHook(src *commentHook) *scm.CommitCommentHook {
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	author := scm.Signature{
+		Name:  src.Commit.Author.Name,
+		Email: src.Commit.Author.Email,
+	}
+	return &scm.CommitCommentHook{
+		Action: convertCommentAction(src.ObjectAttributes.Action),
+		Commit: scm.Commit{
+			Sha:       src.ObjectAttributes.CommitID,
+			Message:   src.Commit.Message,
+			Author:    author,
+			Committer: author,
+			Link:      src.Commit.URL,
+		},
+		Comment: scm.Comment{
+			ID:   src.ObjectAttributes.ID,
+			Body: src.ObjectAttributes.Note,
+			Author: scm.User{
+				Login:  src.User.Username,
+				Name:   src.User.Name,
+				Avatar: src.User.AvatarURL,
+			},
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+func convertReviewCommentHook(src *commentHook) *scm.ReviewCommentHook {
+	// comments on removed lines only have an old line
+	// number and an old path.
+	position := src.ObjectAttributes.Position
+	path, line, side := position.NewPath, position.NewLine, scm.ReviewSideNew
+	if line == 0 {
+		path, line, side = position.OldPath, position.OldLine, scm.ReviewSideOld
+	}
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	return &scm.ReviewCommentHook{
+		Action:      convertCommentAction(src.ObjectAttributes.Action),
+		PullRequest: convertCommentPullRequest(src),
+		Review: scm.Review{
+			ID:   src.ObjectAttributes.ID,
+			Body: src.ObjectAttributes.Note,
+			Path: path,
+			Sha:  position.HeadSha,
+			Line: line,
+			Side: side,
+			Link: src.ObjectAttributes.URL,
+			Author: scm.User{
+				Login:  src.User.Username,
+				Name:   src.User.Name,
+				Avatar: src.User.AvatarURL,
+			},
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+// helper function returns the merge request embedded
+// in the note hook payload.
+func convertCommentPullRequest(src *commentHook) scm.PullRequest {
+	fork := scm.Join(
+		src.MergeRequest.Source.Namespace,
+		src.MergeRequest.Source.Name,
+	)
+	return scm.PullRequest{
+		Number:  src.MergeRequest.Iid,
+		Title:   src.MergeRequest.Title,
+		Body:    src.MergeRequest.Description,
+		Sha:     src.MergeRequest.LastCommit.ID,
+		Ref:     fmt.Sprintf("refs/merge-requests/%d/head", src.MergeRequest.Iid),
+		Source:  src.MergeRequest.SourceBranch,
+		Target:  src.MergeRequest.TargetBranch,
+		Fork:    fork,
+		Link:    src.MergeRequest.URL,
+		Closed:  src.MergeRequest.State != "opened",
+		Merged:  src.MergeRequest.State == "merged",
+		Draft:   src.MergeRequest.WorkInProgress || isDraftTitle(src.MergeRequest.Title),
+		Created: parseTimeString(src.MergeRequest.CreatedAt),
+		Updated: parseTimeString(src.MergeRequest.UpdatedAt),
+	}
+}
+
+// helper function converts the note action to the
+// common action enum. Older versions of gitlab do not
+// include the action, in which case the note is assumed
+// to be newly created.
+func convertCommentAction(src string) scm.Action {
+	switch src {
+	case "update":
+		return scm.ActionUpdate
+	default:
+		return scm.ActionCreate
+	}
+}
+
+// helper function parses the timestamp format used by
+// gitlab webhook payloads (e.g. 2017-12-10 16:43:38 UTC).
+func convertPipelineHook(src *pipelineHook) *scm.PipelineHook {
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	author := scm.Signature{
+		Name:  src.Commit.Author.Name,
+		Email: src.Commit.Author.Email,
+	}
+	ref := scm.ExpandRef(src.ObjectAttributes.Ref, "refs/heads/")
+	if src.ObjectAttributes.Tag {
+		ref = scm.ExpandRef(src.ObjectAttributes.Ref, "refs/tags/")
+	}
+	return &scm.PipelineHook{
+		Number: src.ObjectAttributes.ID,
+		Ref: scm.Reference{
+			Name: src.ObjectAttributes.Ref,
+			Path: ref,
+			Sha:  src.ObjectAttributes.Sha,
+		},
+		State: convertPipelineState(src.ObjectAttributes.Status),
+		Link:  src.ObjectAttributes.URL,
+		Commit: scm.Commit{
+			Sha:       src.Commit.ID,
+			Message:   src.Commit.Message,
+			Author:    author,
+			Committer: author,
+			Link:      src.Commit.URL,
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Email:  src.User.Email,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+// helper function returns the common state for the pipeline
+// status. Pipelines that have not started are pending, and
+// skipped pipelines are canceled.
+func convertPipelineState(from string) scm.State {
+	switch from {
+	case "created", "waiting_for_resource", "preparing", "scheduled", "manual":
+		return scm.StatePending
+	case "skipped":
+		return scm.StateCanceled
+	default:
+		return convertState(from)
+	}
+}
+
+func parseTimeString(s string) time.Time {
+	t, _ := time.Parse("2006-01-02 15:04:05 MST", s)
+	return t
+}
+
 type (
 	pushHook struct {
 		ObjectKind   string      `json:"object_kind"`
@@ -352,6 +743,42 @@ type (
 		} `json:"repository"`
 	}
 
+	pipelineHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			ID     int    `json:"id"`
+			Ref    string `json:"ref"`
+			Tag    bool   `json:"tag"`
+			Sha    string `json:"sha"`
+			Status string `json:"status"`
+			URL    string `json:"url"`
+		} `json:"object_attributes"`
+		User struct {
+			Name      string `json:"name"`
+			Username  string `json:"username"`
+			Email     string `json:"email"`
+			AvatarURL string `json:"avatar_url"`
+		} `json:"user"`
+		Project struct {
+			ID                int    `json:"id"`
+			Name              string `json:"name"`
+			WebURL            string `json:"web_url"`
+			GitSSHURL         string `json:"git_ssh_url"`
+			GitHTTPURL        string `json:"git_http_url"`
+			PathWithNamespace string `json:"path_with_namespace"`
+			DefaultBranch     string `json:"default_branch"`
+		} `json:"project"`
+		Commit struct {
+			ID      string `json:"id"`
+			Message string `json:"message"`
+			URL     string `json:"url"`
+			Author  struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+		} `json:"commit"`
+	}
+
 	commentHook struct {
 		ObjectKind string `json:"object_kind"`
 		User       struct {
@@ -395,14 +822,14 @@ type (
 			UpdatedByID  interface{} `json:"updated_by_id"`
 			Type         string      `json:"type"`
 			Position     struct {
-				BaseSha      string      `json:"base_sha"`
-				StartSha     string      `json:"start_sha"`
-				HeadSha      string      `json:"head_sha"`
-				OldPath      string      `json:"old_path"`
-				NewPath      string      `json:"new_path"`
-				PositionType string      `json:"position_type"`
-				OldLine      interface{} `json:"old_line"`
-				NewLine      int         `json:"new_line"`
+				BaseSha      string `json:"base_sha"`
+				StartSha     string `json:"start_sha"`
+				HeadSha      string `json:"head_sha"`
+				OldPath      string `json:"old_path"`
+				NewPath      string `json:"new_path"`
+				PositionType string `json:"position_type"`
+				OldLine      int    `json:"old_line"`
+				NewLine      int    `json:"new_line"`
 			} `json:"position"`
 			OriginalPosition struct {
 				BaseSha      string      `json:"base_sha"`
@@ -429,6 +856,7 @@ type (
 			} `json:"change_position"`
 			ResolvedByPush interface{} `json:"resolved_by_push"`
 			URL            string      `json:"url"`
+			Action         string      `json:"action"`
 		} `json:"object_attributes"`
 		Repository struct {
 			Name        string `json:"name"`
@@ -515,6 +943,45 @@ type (
 			HumanTotalTimeSpent interface{} `json:"human_total_time_spent"`
 			HumanTimeEstimate   interface{} `json:"human_time_estimate"`
 		} `json:"merge_request"`
+		Issue struct {
+			AssigneeID          interface{}   `json:"assignee_id"`
+			AuthorID            int           `json:"author_id"`
+			BranchName          interface{}   `json:"branch_name"`
+			ClosedAt            interface{}   `json:"closed_at"`
+			Confidential        bool          `json:"confidential"`
+			CreatedAt           string        `json:"created_at"`
+			DeletedAt           interface{}   `json:"deleted_at"`
+			Description         string        `json:"description"`
+			DueDate             interface{}   `json:"due_date"`
+			ID                  int           `json:"id"`
+			Iid                 int           `json:"iid"`
+			LastEditedAt        string        `json:"last_edited_at"`
+			LastEditedByID      int           `json:"last_edited_by_id"`
+			MilestoneID         interface{}   `json:"milestone_id"`
+			MovedToID           interface{}   `json:"moved_to_id"`
+			ProjectID           int           `json:"project_id"`
+			RelativePosition    int           `json:"relative_position"`
+			State               string        `json:"state"`
+			TimeEstimate        int           `json:"time_estimate"`
+			Title               string        `json:"title"`
+			UpdatedAt           string        `json:"updated_at"`
+			UpdatedByID         int           `json:"updated_by_id"`
+			URL                 string        `json:"url"`
+			TotalTimeSpent      int           `json:"total_time_spent"`
+			HumanTotalTimeSpent interface{}   `json:"human_total_time_spent"`
+			HumanTimeEstimate   interface{}   `json:"human_time_estimate"`
+			AssigneeIds         []interface{} `json:"assignee_ids"`
+		} `json:"issue"`
+		Commit struct {
+			ID        string `json:"id"`
+			Message   string `json:"message"`
+			Timestamp string `json:"timestamp"`
+			URL       string `json:"url"`
+			Author    struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+		} `json:"commit"`
 	}
 
 	tagHook struct {
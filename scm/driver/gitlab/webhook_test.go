@@ -58,20 +58,64 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
-		// // issue hooks
-		// {
-		// 	event:  "issues",
-		// 	before: "testdata/webhooks/issues_opened.json",
-		// 	after:  "testdata/webhooks/issues_opened.json.golden",
-		// 	obj:    new(scm.IssueHook),
-		// },
-		// // issue comment hooks
-		// {
-		// 	event:  "issue_comment",
-		// 	before: "testdata/webhooks/issue_comment_created.json",
-		// 	after:  "testdata/webhooks/issue_comment_created.json.golden",
-		// 	obj:    new(scm.IssueCommentHook),
-		// },
+		// issue hooks
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_create.json",
+			after:  "testdata/webhooks/issue_create.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_edited.json",
+			after:  "testdata/webhooks/issue_edited.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_labeled.json",
+			after:  "testdata/webhooks/issue_labeled.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_closed.json",
+			after:  "testdata/webhooks/issue_closed.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_reopen.json",
+			after:  "testdata/webhooks/issue_reopen.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "Confidential Issue Hook",
+			before: "testdata/webhooks/issue_confidential.json",
+			after:  "testdata/webhooks/issue_confidential.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/issue_comment_create.json",
+			after:  "testdata/webhooks/issue_comment_create.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
+		// commit comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/commit_comment_create.json",
+			after:  "testdata/webhooks/commit_comment_create.json.golden",
+			obj:    new(scm.CommitCommentHook),
+		},
+		// pipeline hooks
+		{
+			event:  "Pipeline Hook",
+			before: "testdata/webhooks/pipeline.json",
+			after:  "testdata/webhooks/pipeline.json.golden",
+			obj:    new(scm.PipelineHook),
+		},
 		// pull request hooks
 		{
 			event:  "Merge Request Hook",
@@ -109,13 +153,20 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merge.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
-		// // pull request comment hooks
-		// {
-		// 	event:  "issue_comment",
-		// 	before: "testdata/webhooks/pull_request_comment_created.json",
-		// 	after:  "testdata/webhooks/pull_request_comment_created.json.golden",
-		// 	obj:    new(scm.PullRequestCommentHook),
-		// },
+		// pull request comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/pull_request_comment_create.json",
+			after:  "testdata/webhooks/pull_request_comment_create.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// review comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/review_comment_create.json",
+			after:  "testdata/webhooks/review_comment_create.json.golden",
+			obj:    new(scm.ReviewCommentHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -176,6 +227,19 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhook_ErrUnknownEvent(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Job Hook")
+	r.Header.Set("X-Gitlab-Token", "topsecret")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
 func TestWebhook_SignatureValid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/branch_delete.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
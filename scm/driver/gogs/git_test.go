@@ -133,3 +133,51 @@ func TestTagList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestBranchCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.CreateBranch(context.Background(), "gogits/gogs", "release", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestBranchDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "gogits/gogs", "release")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestBranchProtectionFind(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.FindBranchProtection(context.Background(), "gogits/gogs", "master")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestBranchProtectionUpdate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.UpdateBranchProtection(context.Background(), "gogits/gogs", "master", &scm.BranchProtection{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestTagCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.CreateTag(context.Background(), "gogits/gogs", &scm.TagInput{Name: "v1.0.0"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestTagDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.DeleteTag(context.Background(), "gogits/gogs", "v1.0.0")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -9,9 +9,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
-	"net/http"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -33,10 +32,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGogs
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
+	client.Releases = &releaseService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -78,9 +81,7 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		return res, errors.New(
-			http.StatusText(res.Status),
-		)
+		return res, convertError(res)
 	}
 
 	if out == nil {
@@ -98,3 +99,42 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
+
+// gogs error object.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// gogs validation error object.
+type fieldError struct {
+	FieldNames     []string `json:"fieldNames"`
+	Classification string   `json:"classification"`
+	Message        string   `json:"message"`
+}
+
+// helper function decodes the error response and converts
+// it to the common error type. Validation errors are
+// returned as a list of field errors.
+func convertError(res *scm.Response) *scm.Error {
+	dst := &scm.Error{
+		Status: res.Status,
+		Driver: scm.DriverGogs,
+	}
+	raw, _ := ioutil.ReadAll(res.Body)
+	src := new(apiError)
+	var fields []*fieldError
+	if json.Unmarshal(raw, src) == nil {
+		dst.Message = src.Message
+	} else if json.Unmarshal(raw, &fields) == nil {
+		for _, field := range fields {
+			for _, name := range field.FieldNames {
+				dst.Fields = append(dst.Fields, scm.FieldError{
+					Field:   name,
+					Code:    field.Classification,
+					Message: field.Message,
+				})
+			}
+		}
+	}
+	return dst
+}
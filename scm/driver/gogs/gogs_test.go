@@ -5,7 +5,17 @@
 // Package gogs implements a Gogs client.
 package gogs
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
 
 func TestClient(t *testing.T) {
 	client, err := New("https://try.gogs.io")
@@ -33,3 +43,32 @@ func TestClient_Error(t *testing.T) {
 		t.Errorf("Expect error when invalid URL")
 	}
 }
+
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/issues").
+		Reply(422).
+		Type("application/json").
+		File("testdata/error_validation.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.Create(context.Background(), "gogits/gogs", &scm.IssueInput{})
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+	if !errors.Is(err, scm.ErrValidation) {
+		t.Errorf("Expect error matches scm.ErrValidation")
+	}
+
+	want := new(scm.Error)
+	raw, _ := ioutil.ReadFile("testdata/error_validation.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
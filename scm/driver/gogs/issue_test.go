@@ -7,6 +7,7 @@ package gogs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -74,6 +75,10 @@ func TestIssueCreate(t *testing.T) {
 
 	gock.New("https://try.gogs.io").
 		Post("/api/v1/repos/gogits/gogs/issues").
+		JSON(map[string]string{
+			"title": "Bug found",
+			"body":  "I'm having a problem with this.",
+		}).
 		Reply(200).
 		Type("application/json").
 		File("testdata/issue.json")
@@ -99,11 +104,139 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCreate_Assignees(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Assignees: []string{"janedoe", "johnsmith"},
+	}
+	_, _, err := client.Issues.Create(context.Background(), "gogits/gogs", &input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string][]int{"labels": {1}}).
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"state":     "closed",
+			"assignee":  "janedoe",
+			"milestone": 1,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	milestone := 1
+	input := &scm.IssueInput{
+		Title:     "Bug found",
+		State:     scm.IssueStateClosed,
+		Labels:    []string{"bug"},
+		Assignees: []string{"janedoe"},
+		Milestone: &milestone,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Issues.Update(context.Background(), "gogits/gogs", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueUpdate_ClearAssignee(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"assignee": ""}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := &scm.IssueInput{
+		Assignees: []string{},
+	}
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.Update(context.Background(), "gogits/gogs", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Reopen(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
@@ -123,6 +256,93 @@ func TestIssueUnlock(t *testing.T) {
 	}
 }
 
+func TestIssueAddAssignees(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.AddAssignees(context.Background(), "gogits/go-gogs-client", 1, []string{"gogs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestIssueAddLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string][]int{"labels": {2}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.AddLabel(context.Background(), "gogits/gogs", 1, "enhancement")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/issues/1/labels/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.RemoveLabel(context.Background(), "gogits/gogs", 1, "bug")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueSetLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string][]int{"labels": {2, 1}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.SetLabels(context.Background(), "gogits/gogs", 1, []string{"enhancement", "bug"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 //
 // issue comment sub-tests
 //
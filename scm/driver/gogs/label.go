@@ -0,0 +1,175 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// labelService implements the label service. Gogs
+// identifies labels by id, so labels are looked up by name
+// in the repository label list. Gogs labels have no
+// description.
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+// List returns the repository labels. Gogs does not
+// paginate labels, so the list options are ignored.
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	if input.Description != "" {
+		return nil, nil, &scm.OptionError{Option: "Description"}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertFromColor(input.Color),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	if input.Description != "" {
+		return nil, nil, &scm.OptionError{Option: "Description"}
+	}
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertFromColor(input.Color),
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	labels, res, err := s.findLabels(ctx, repo, []string{name})
+	if err != nil {
+		return nil, res, err
+	}
+	return labels[0], res, nil
+}
+
+// helper function returns the repository labels with the
+// names, in the same order.
+func (s *labelService) findLabels(ctx context.Context, repo string, names []string) ([]*label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	var labels []*label
+	for _, name := range names {
+		var found *label
+		for _, v := range out {
+			if v.Name == name {
+				found = v
+				break
+			}
+		}
+		if found == nil {
+			return nil, res, scm.ErrNotFound
+		}
+		labels = append(labels, found)
+	}
+	return labels, res, nil
+}
+
+//
+// native data structures
+//
+
+type (
+	// gogs label response object.
+	label struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	}
+
+	// gogs label request object.
+	labelInput struct {
+		Name  string `json:"name,omitempty"`
+		Color string `json:"color,omitempty"`
+	}
+
+	// gogs issue labels request object.
+	issueLabelsInput struct {
+		Labels []int `json:"labels"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertLabelList(src []*label) []*scm.Label {
+	dst := []*scm.Label{}
+	for _, v := range src {
+		dst = append(dst, convertLabel(v))
+	}
+	return dst
+}
+
+func convertLabel(src *label) *scm.Label {
+	return &scm.Label{
+		Name:  src.Name,
+		Color: strings.TrimPrefix(src.Color, "#"),
+	}
+}
+
+// helper function returns the labels of an issue or pull
+// request, or nil if there are no labels.
+func convertLabels(src []*label) []scm.Label {
+	var dst []scm.Label
+	for _, v := range src {
+		dst = append(dst, *convertLabel(v))
+	}
+	return dst
+}
+
+// helper function returns the label color with the leading
+// hash, which Gogs requires.
+func convertFromColor(color string) string {
+	if color == "" || strings.HasPrefix(color, "#") {
+		return color
+	}
+	return "#" + color
+}
@@ -38,6 +38,10 @@ func (s *pullService) Create(context.Context, string, *scm.PullRequestInput) (*s
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Update(context.Context, string, int, *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -46,7 +50,7 @@ func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Res
 	return nil, scm.ErrNotSupported
 }
 
-func (s *pullService) Merge(context.Context, string, int) (*scm.Response, error) {
+func (s *pullService) Merge(context.Context, string, int, *scm.MergeInput) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
@@ -54,6 +58,43 @@ func (s *pullService) Close(context.Context, string, int) (*scm.Response, error)
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Reopen(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// AddLabel adds the label to the pull request. Gogs pull
+// requests are issues, so the issue labels are used.
+func (s *pullService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabel(ctx, repo, number, label)
+}
+
+func (s *pullService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, label)
+}
+
+func (s *pullService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.SetLabels(ctx, repo, number, labels)
+}
+
+func (s *pullService) RequestReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) AddAssignees(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveAssignees(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -47,9 +47,41 @@ func TestPullRequestClose(t *testing.T) {
 	}
 }
 
+func TestPullRequestReopen(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.PullRequests.Reopen(context.Background(), "gogits/gogs", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullRequestUpdate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.PullRequests.Update(context.Background(), "gogits/gogs", 1, &scm.PullRequestUpdateInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "gogits/gogs", 1, &scm.ReviewerInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullRequestAssignees(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.PullRequests.AddAssignees(context.Background(), "gogits/gogs", 1, []string{"gogs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullRequestMerge(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
-	_, err := client.PullRequests.Merge(context.Background(), "gogits/gogs", 1)
+	_, err := client.PullRequests.Merge(context.Background(), "gogits/gogs", 1, nil)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
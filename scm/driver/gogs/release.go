@@ -0,0 +1,102 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// releaseService implements the release service. The gogs
+// api only provides read access to the list of releases.
+type releaseService struct {
+	client *wrapper
+}
+
+func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) FindByTag(ctx context.Context, repo, tag string) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) List(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases", repo)
+	out := []*release{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseList(out), res, err
+}
+
+func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UpdateByTag(ctx context.Context, repo, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DeleteByTag(ctx context.Context, repo, tag string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, id int, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+//
+// native data structures
+//
+
+type (
+	// gogs release object.
+	release struct {
+		ID          int       `json:"id"`
+		Title       string    `json:"name"`
+		Description string    `json:"body"`
+		Tag         string    `json:"tag_name"`
+		Commitish   string    `json:"target_commitish"`
+		Draft       bool      `json:"draft"`
+		Prerelease  bool      `json:"prerelease"`
+		Created     time.Time `json:"created_at"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertReleaseList(src []*release) []*scm.Release {
+	dst := []*scm.Release{}
+	for _, v := range src {
+		dst = append(dst, convertRelease(v))
+	}
+	return dst
+}
+
+func convertRelease(src *release) *scm.Release {
+	return &scm.Release{
+		ID:          src.ID,
+		Title:       src.Title,
+		Description: src.Description,
+		Tag:         src.Tag,
+		Commitish:   src.Commitish,
+		Draft:       src.Draft,
+		Prerelease:  src.Prerelease,
+		Created:     src.Created,
+		Published:   src.Created,
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Releases.Find(context.Background(), "gogits/gogs", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/releases").
+		Reply(200).
+		Type("application/json").
+		File("testdata/releases.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Releases.List(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Releases.Create(context.Background(), "gogits/gogs", &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Releases.Delete(context.Background(), "gogits/gogs", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
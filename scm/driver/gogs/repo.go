@@ -31,6 +31,13 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+func (s *repositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s", repo)
 	out := new(repository)
@@ -52,6 +59,13 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, _ scm.Li
 	return convertHookList(out), res, err
 }
 
+func (s *repositoryService) ListKeys(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
 func (s *repositoryService) ListStatus(context.Context, string, string, scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -73,6 +87,23 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateKey creates a new deploy key. Gogs deploy keys are
+// always read-only, and a key with write access returns an
+// OptionError.
+func (s *repositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	if !input.ReadOnly {
+		return nil, nil, &scm.OptionError{Option: "ReadOnly"}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 func (s *repositoryService) CreateStatus(context.Context, string, string, *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -99,6 +130,11 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) DeleteKey(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -143,6 +179,20 @@ type (
 		ContentType string `json:"content_type"`
 		Secret      string `json:"secret"`
 	}
+
+	// gogs deploy key resource.
+	key struct {
+		ID      int       `json:"id"`
+		Title   string    `json:"title"`
+		Key     string    `json:"key"`
+		Created time.Time `json:"created_at"`
+	}
+
+	// gogs deploy key creation request.
+	keyInput struct {
+		Title string `json:"title"`
+		Key   string `json:"key"`
+	}
 )
 
 //
@@ -196,6 +246,24 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(src []*key) []*scm.DeployKey {
+	var dst []*scm.DeployKey
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: true, // gogs deploy keys are always read-only
+		Created:  from.Created,
+	}
+}
+
 func convertHookEvent(from scm.HookEvents) []string {
 	var events []string
 	if from.PullRequest {
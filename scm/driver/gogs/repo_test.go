@@ -301,3 +301,114 @@ func TestStatusCreate(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestRepositoryKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.FindKey(context.Background(), "gogits/gogs", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.ListKeys(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/keys").
+		File("testdata/key_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	in := &scm.DeployKeyInput{
+		Title:    "deploy",
+		Key:      "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.CreateKey(context.Background(), "gogits/gogs", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyCreate_ReadWrite(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.CreateKey(context.Background(), "gogits/gogs", &scm.DeployKeyInput{
+		Title: "deploy",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+	})
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "ReadOnly" {
+		t.Errorf("Want ReadOnly OptionError, got %v", err)
+	}
+}
+
+func TestRepositoryKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/keys/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Repositories.DeleteKey(context.Background(), "gogits/gogs", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
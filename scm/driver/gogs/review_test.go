@@ -42,3 +42,35 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListSummaries(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Reviews.ListSummaries(context.Background(), "gogits/gogs", 1, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Reviews.Submit(context.Background(), "gogits/gogs", 1, &scm.ReviewSummaryInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Reviews.Dismiss(context.Background(), "gogits/gogs", 1, 1, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewFindApprovals(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Reviews.FindApprovals(context.Background(), "gogits/gogs", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"mime/multipart"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -28,17 +29,59 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	return s.edit(ctx, repo, path, params, "")
 }
 
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	return s.edit(ctx, repo, path, params, params.Sha)
 }
 
+// Delete is not supported. The Bitbucket Server API can
+// create and edit files, but cannot delete them.
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// edit commits the file contents to the branch. The source
+// commit is the commit the file was last read at, and must
+// be empty when creating a new file.
+func (s *contentService) edit(ctx context.Context, repo, path string, params *scm.ContentParams, sourceCommit string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, name, path)
+	buf := new(bytes.Buffer)
+	form := multipart.NewWriter(buf)
+	fields := []struct {
+		name, value string
+	}{
+		{"branch", params.Branch},
+		{"message", params.Message},
+		{"sourceCommitId", sourceCommit},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if err := form.WriteField(field.name, field.value); err != nil {
+			return nil, err
+		}
+	}
+	part, err := form.CreateFormField("content")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(params.Data); err != nil {
+		return nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+	in := &rawBody{
+		contentType: form.FormDataContentType(),
+		data:        buf,
+	}
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, opts scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/files/%s?at=%s&%s", namespace, name, path, ref, encodeListOptions(opts))
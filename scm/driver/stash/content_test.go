@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
@@ -43,18 +44,66 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
-	content := new(contentService)
-	_, err := content.Create(context.Background(), "atlassian/atlaskit", "README", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		SetMatcher(matchForm(map[string]string{
+			"branch":  "master",
+			"message": "add README",
+			"content": "Hello World\n",
+		})).
+		Reply(200).
+		Type("application/json").
+		BodyString("{}")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "add README",
+		Data:    []byte("Hello World\n"),
+	}
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Contents.Create(context.Background(), "PRJ/my-repo", "README", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
-	content := new(contentService)
-	_, err := content.Update(context.Background(), "atlassian/atlaskit", "README", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		SetMatcher(matchForm(map[string]string{
+			"branch":         "master",
+			"message":        "update README",
+			"content":        "Hello World\n",
+			"sourceCommitId": "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		})).
+		Reply(200).
+		Type("application/json").
+		BodyString("{}")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "update README",
+		Data:    []byte("Hello World\n"),
+		Sha:     "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Contents.Update(context.Background(), "PRJ/my-repo", "README", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
 }
 
@@ -97,3 +146,28 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+// helper function returns a gock matcher that matches the
+// request with the default matchers and the multipart form
+// fields.
+func matchForm(want map[string]string) gock.Matcher {
+	matcher := gock.NewEmptyMatcher()
+	for _, fn := range gock.Matchers {
+		matcher.Add(fn)
+	}
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return false, err
+		}
+		if len(req.MultipartForm.Value) != len(want) {
+			return false, nil
+		}
+		for k, v := range want {
+			if req.FormValue(k) != v {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	return matcher
+}
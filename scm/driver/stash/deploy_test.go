@@ -0,0 +1,52 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.Find(context.Background(), "", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentList(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.List(context.Background(), "", scm.DeploymentListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.Create(context.Background(), "", &scm.DeploymentInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.CreateStatus(context.Background(), "", 1, &scm.DeployStatusInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentListStatuses(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.ListStatuses(context.Background(), "", 1, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -59,6 +59,10 @@ func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Refere
 	return nil, res, scm.ErrNotFound
 }
 
+func (s *gitService) FindBranchProtection(ctx context.Context, repo, name string) (*scm.BranchProtection, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?%s", namespace, name, encodeListOptions(opts))
@@ -99,6 +103,48 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo, name, sha string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches", namespace, repoName)
+	in := &branchInput{
+		Name:       name,
+		StartPoint: sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Reference, *scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags", namespace, repoName)
+	in := &tagInput{
+		Name:       input.Name,
+		StartPoint: input.Sha,
+		Message:    input.Message,
+	}
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTag(out), res, err
+}
+
+func (s *gitService) UpdateBranchProtection(ctx context.Context, repo, name string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-utils/1.0/projects/%s/repos/%s/branches", namespace, repoName)
+	in := &branchDeleteInput{
+		Name: scm.ExpandRef(name, "refs/heads"),
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/git/1.0/projects/%s/repos/%s/tags/%s", namespace, repoName, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type branch struct {
 	ID              string `json:"id"`
 	DisplayID       string `json:"displayId"`
@@ -108,6 +154,21 @@ type branch struct {
 	IsDefault       bool   `json:"isDefault"`
 }
 
+type branchInput struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+}
+
+type branchDeleteInput struct {
+	Name string `json:"name"`
+}
+
+type tagInput struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
 type commits struct {
 	pagination
 	Values []*commit `json:"values"`
@@ -210,3 +210,102 @@ func TestGitCompareChanges(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]string{
+			"name":       "release/1.0",
+			"startPoint": "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.CreateBranch(context.Background(), "PRJ/my-repo", "release/1.0", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]string{"name": "refs/heads/release/1.0"}).
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.DeleteBranch(context.Background(), "PRJ/my-repo", "release/1.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitFindBranchProtection(t *testing.T) {
+	_, _, err := NewDefault().Git.FindBranchProtection(context.Background(), "PRJ/my-repo", "master")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGitUpdateBranchProtection(t *testing.T) {
+	_, _, err := NewDefault().Git.UpdateBranchProtection(context.Background(), "PRJ/my-repo", "master", &scm.BranchProtection{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]string{
+			"name":       "v1.0.0",
+			"startPoint": "11ce869211917dd65610e70fcee454943b35ac6e",
+			"message":    "initial version",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_create.json")
+
+	in := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "11ce869211917dd65610e70fcee454943b35ac6e",
+		Message: "initial version",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.CreateTag(context.Background(), "PRJ/my-repo", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.DeleteTag(context.Background(), "PRJ/my-repo", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
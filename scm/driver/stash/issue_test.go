@@ -67,6 +67,20 @@ func TestIssueClose(t *testing.T) {
 	}
 }
 
+func TestIssueUpdate(t *testing.T) {
+	_, _, err := NewDefault().Issues.Update(context.Background(), "", 0, &scm.IssueInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	_, err := NewDefault().Issues.Reopen(context.Background(), "", 0)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	_, err := NewDefault().Issues.Lock(context.Background(), "", 0)
 	if err != scm.ErrNotSupported {
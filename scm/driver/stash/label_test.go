@@ -0,0 +1,52 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestLabelFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Labels.Find(context.Background(), "PRJ/my-repo", "bug")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestLabelList(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Labels.List(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Labels.Create(context.Background(), "PRJ/my-repo", &scm.LabelInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Labels.Update(context.Background(), "PRJ/my-repo", "bug", &scm.LabelInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Labels.Delete(context.Background(), "PRJ/my-repo", "bug")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -56,20 +57,72 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertDiffstats(out), res, err
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	// TODO(bradrydzewski) the challenge with comments is that we need to use
-	// the activities endpoint, which returns entries that may or may not be
-	// comments. This complicates how we handle counts and pagination.
-
-	// GET /rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities
-	return nil, nil, scm.ErrNotSupported
+// ListComments returns the pull request comments. Comments
+// are read from the pull request activities, so a page may
+// contain fewer comments than the page size.
+func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertActivityComments(out), res, err
 }
 
-func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+// Merge merges the pull request. Bitbucket Server requires
+// the current pull request version, so the pull request is
+// fetched first, and the version guards against the source
+// branch moving after the expected head commit is checked.
+// The source branch is deleted after the merge, unless it is
+// in a fork.
+func (s *pullService) Merge(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input == nil {
+		input = new(scm.MergeInput)
+	}
+	if input.MergeWhenPipelineSucceeds {
+		return nil, &scm.OptionError{Option: "MergeWhenPipelineSucceeds"}
+	}
+	in := &prMergeInput{
+		Message: input.Title,
+	}
+	if input.Message != "" {
+		in.Message = strings.TrimSpace(input.Title + "\n\n" + input.Message)
+	}
+	switch input.Method {
+	case scm.MergeMethodMerge:
+		in.StrategyID = "no-ff"
+	case scm.MergeMethodSquash:
+		in.StrategyID = "squash"
+	case scm.MergeMethodRebase:
+		in.StrategyID = "rebase-ff-only"
+	case scm.MergeMethodFastForward:
+		in.StrategyID = "ff-only"
+	}
 	namespace, name := scm.Split(repo)
-	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/merge", namespace, name, number)
-	res, err := s.client.do(ctx, "POST", path, nil, nil)
-	return res, err
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	if input.Sha != "" && input.Sha != out.FromRef.LatestCommit {
+		return res, scm.ErrConflict
+	}
+	path = fmt.Sprintf("%s/merge?version=%d", path, out.Version)
+	res, err = s.client.do(ctx, "POST", path, in, nil)
+	if err != nil || !input.DeleteSourceBranch {
+		return res, err
+	}
+	// branches in a fork are not deleted.
+	source := out.FromRef.Repository
+	if source.ID != out.ToRef.Repository.ID {
+		return res, nil
+	}
+	git := &gitService{s.client}
+	if _, err := git.DeleteBranch(ctx, source.Project.Key+"/"+source.Slug, out.FromRef.ID); err != nil {
+		return res, &scm.DeleteBranchError{Branch: out.FromRef.DisplayID, Err: err}
+	}
+	return res, nil
 }
 
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -79,6 +132,80 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+// Reopen reopens the declined pull request. Bitbucket Server
+// requires the current pull request version, so the pull
+// request is fetched first.
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("%s/reopen?version=%d", path, out.Version)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// RequestReviewers adds the users to the pull request as
+// reviewers. Bitbucket Server has no team reviewers.
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants", namespace, name, number)
+	var res *scm.Response
+	for _, login := range input.Users {
+		in := new(participantRoleInput)
+		in.User.Name = login
+		in.Role = "REVIEWER"
+		var err error
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, &scm.OptionError{Option: "Teams"}
+	}
+	namespace, name := scm.Split(repo)
+	var res *scm.Response
+	for _, login := range input.Users {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, login)
+		var err error
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) AddLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) AddAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", namespace, name)
@@ -91,11 +218,61 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	in.ToRef.Repository.Project.Key = namespace
 	in.ToRef.Repository.Slug = name
 	in.ToRef.ID = scm.ExpandRef(input.Target, "refs/heads")
+	in.Draft = input.Draft
 	out := new(pr)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertPullRequest(out), res, err
 }
 
+// Update updates the pull request. Bitbucket Server requires
+// the current pull request version, so the pull request is
+// fetched first. The state is changed by declining or
+// reopening the pull request after it is updated.
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if input.Title != "" || input.Body != "" || input.Target != "" {
+		in := &prUpdateInput{
+			Version:     out.Version,
+			Title:       out.Title,
+			Description: out.Description,
+		}
+		in.ToRef.ID = out.ToRef.ID
+		if input.Title != "" {
+			in.Title = input.Title
+		}
+		if input.Body != "" {
+			in.Description = input.Body
+		}
+		if input.Target != "" {
+			in.ToRef.ID = scm.ExpandRef(input.Target, "refs/heads")
+		}
+		out = new(pr)
+		res, err = s.client.do(ctx, "PUT", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	var action string
+	switch {
+	case input.State == scm.PullRequestStateClosed && out.State == "OPEN":
+		action = "decline"
+	case input.State == scm.PullRequestStateOpen && out.State == "DECLINED":
+		action = "reopen"
+	default:
+		return convertPullRequest(out), res, nil
+	}
+	path = fmt.Sprintf("%s/%s?version=%d", path, action, out.Version)
+	out = new(pr)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	return convertPullRequest(out), res, err
+}
+
 func (s *pullService) CreateComment(ctx context.Context, repo string, number int, in *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	input := pullRequestCommentInput{Text: in.Body}
 	namespace, name := scm.Split(repo)
@@ -105,13 +282,19 @@ func (s *pullService) CreateComment(ctx context.Context, repo string, number int
 	return convertPullRequestComment(out), res, err
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	// TODO(bradrydzewski) the challenge with deleting comments is that we need to specify
-	// the comment version number. The proposal is to use 0 as the initial version number,
-	// and then to use expectedVersion on error and re-attempt the API call.
-
-	// DELETE /rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1?version=0
-	return nil, scm.ErrNotSupported
+// DeleteComment deletes the pull request comment. Bitbucket
+// Server requires the current comment version, so the
+// comment is fetched before it is deleted.
+func (s *pullService) DeleteComment(ctx context.Context, repo string, number int, id int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("%s?version=%d", path, out.Version)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 type pr struct {
@@ -122,6 +305,7 @@ type pr struct {
 	State       string `json:"state"`
 	Open        bool   `json:"open"`
 	Closed      bool   `json:"closed"`
+	Draft       bool   `json:"draft"`
 	CreatedDate int64  `json:"createdDate"`
 	UpdatedDate int64  `json:"updatedDate"`
 	FromRef     struct {
@@ -156,8 +340,8 @@ type pr struct {
 		Approved bool   `json:"approved"`
 		Status   string `json:"status"`
 	} `json:"author"`
-	Reviewers    []interface{} `json:"reviewers"`
-	Participants []interface{} `json:"participants"`
+	Reviewers    []*participant `json:"reviewers"`
+	Participants []interface{}  `json:"participants"`
 	Links        struct {
 		Self []link `json:"self"`
 	} `json:"links"`
@@ -189,6 +373,16 @@ type prInput struct {
 			} `json:"project"`
 		} `json:"repository"`
 	} `json:"toRef"`
+	Draft bool `json:"draft,omitempty"`
+}
+
+type prUpdateInput struct {
+	Version     int    `json:"version"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ToRef       struct {
+		ID string `json:"id"`
+	} `json:"toRef"`
 }
 
 func convertPullRequests(from *prs) []*scm.PullRequest {
@@ -216,6 +410,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Link:    extractSelfLink(from.Links.Self),
 		Closed:  from.Closed,
 		Merged:  from.State == "MERGED",
+		Draft:   from.Draft,
 		Created: time.Unix(from.CreatedDate/1000, 0),
 		Updated: time.Unix(from.UpdatedDate/1000, 0),
 		Author: scm.User{
@@ -224,9 +419,18 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Email:  from.Author.User.EmailAddress,
 			Avatar: avatarLink(from.Author.User.EmailAddress),
 		},
+		Reviewers: convertReviewerList(from.Reviewers),
 	}
 }
 
+func convertReviewerList(from []*participant) []scm.User {
+	var to []scm.User
+	for _, v := range from {
+		to = append(to, *convertUser(&v.User))
+	}
+	return to
+}
+
 type pullRequestComment struct {
 	Properties struct {
 		RepositoryID int `json:"repositoryId"`
@@ -248,18 +452,38 @@ type pullRequestComment struct {
 			} `json:"self"`
 		} `json:"links"`
 	} `json:"author"`
-	CreatedDate         int64         `json:"createdDate"`
-	UpdatedDate         int64         `json:"updatedDate"`
-	Comments            []interface{} `json:"comments"`
-	Tasks               []interface{} `json:"tasks"`
+	Anchor              *commentAnchor `json:"anchor"`
+	CreatedDate         int64          `json:"createdDate"`
+	UpdatedDate         int64          `json:"updatedDate"`
+	Comments            []interface{}  `json:"comments"`
+	Tasks               []interface{}  `json:"tasks"`
 	PermittedOperations struct {
 		Editable  bool `json:"editable"`
 		Deletable bool `json:"deletable"`
 	} `json:"permittedOperations"`
 }
 
+type activities struct {
+	pagination
+	Values []*activity `json:"values"`
+}
+
+type activity struct {
+	ID            int                 `json:"id"`
+	Action        string              `json:"action"`
+	CommentAction string              `json:"commentAction"`
+	Comment       *pullRequestComment `json:"comment"`
+	CommentAnchor *commentAnchor      `json:"commentAnchor"`
+}
+
+type prMergeInput struct {
+	Message    string `json:"message,omitempty"`
+	StrategyID string `json:"strategyId,omitempty"`
+}
+
 type pullRequestCommentInput struct {
-	Text string `json:"text"`
+	Text   string         `json:"text"`
+	Anchor *commentAnchor `json:"anchor,omitempty"`
 }
 
 func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
@@ -276,3 +500,17 @@ func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
 		},
 	}
 }
+
+// helper function returns the comments added in the pull
+// request activities. Other activities, such as approvals
+// and comment edits, are skipped.
+func convertActivityComments(from *activities) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from.Values {
+		if v.Action != "COMMENTED" || v.CommentAction != "ADDED" || v.Comment == nil {
+			continue
+		}
+		to = append(to, convertPullRequestComment(v.Comment))
+	}
+	return to
+}
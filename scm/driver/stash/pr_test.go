@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -66,6 +67,33 @@ func TestPullFindComment(t *testing.T) {
 	}
 }
 
+func TestPullListComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comments.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.ListComments(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 25, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/pr_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -121,17 +149,132 @@ func TestPullListChanges(t *testing.T) {
 func TestPullMerge(t *testing.T) {
 	defer gock.Off()
 
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		MatchParam("version", "0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullMerge_Options(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
 	gock.New("http://example.com:7990").
 		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		MatchParam("version", "0").
+		JSON(map[string]string{
+			"message":    "Add feature\n\nDetails",
+			"strategyId": "squash",
+		}).
 		Reply(200).
 		Type("application/json").
 		File("testdata/pr.json")
 
+	gock.New("http://example.com:7990").
+		Delete("rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]string{"name": "refs/heads/feature/x"}).
+		Reply(204)
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		Title:              "Add feature",
+		Message:            "Details",
+		Sha:                "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		DeleteSourceBranch: true,
+	}
+
 	client, _ := New("http://example.com:7990")
-	_, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1)
+	_, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1, input)
 	if err != nil {
 		t.Error(err)
 	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullMerge_DeleteBranchError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Delete("rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		Reply(401).
+		Type("application/json").
+		BodyString(`{"errors": [{"message": "You are not permitted to delete this branch"}]}`)
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1, &scm.MergeInput{
+		DeleteSourceBranch: true,
+	})
+	if _, ok := err.(*scm.DeleteBranchError); !ok {
+		t.Errorf("Want DeleteBranchError, got %v", err)
+	}
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want merge response status %d, got %d", want, got)
+	}
+}
+
+func TestPullMerge_Sha(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.MergeInput{
+		Sha: "2eba238e33607c1fa49253182e9fff42baafa1eb",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1, input)
+	if err != scm.ErrConflict {
+		t.Errorf("Expect Conflict error, got %v", err)
+	}
+}
+
+func TestPullMerge_NotSupported(t *testing.T) {
+	input := &scm.MergeInput{MergeWhenPipelineSucceeds: true}
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.Merge(context.Background(), "PRJ/my-repo", 1, input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
 }
 
 func TestPullClose(t *testing.T) {
@@ -150,6 +293,149 @@ func TestPullClose(t *testing.T) {
 	}
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_declined.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/reopen").
+		MatchParam("version", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_update.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.Reopen(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants").
+		JSON(map[string]interface{}{
+			"user": map[string]string{"name": "jsmith"},
+			"role": "REVIEWER",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jsmith").
+		Reply(204).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestReviewers_Teams(t *testing.T) {
+	input := &scm.ReviewerInput{Teams: []string{"developers"}}
+	_, err := NewDefault().PullRequests.RequestReviewers(context.Background(), "PRJ/my-repo", 1, input)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullAddAssignees(t *testing.T) {
+	_, err := NewDefault().PullRequests.AddAssignees(context.Background(), "PRJ/my-repo", 1, []string{"jsmith"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		JSON(map[string]interface{}{
+			"version":     0,
+			"title":       "Updated License",
+			"description": "* added LICENSE\r\n* update files\r\n* update files",
+			"toRef":       map[string]string{"id": "refs/heads/develop"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_update.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/decline").
+		MatchParam("version", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_declined.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Title:  "Updated License",
+		Target: "develop",
+		State:  scm.PullRequestStateClosed,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.Update(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr_declined.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -208,3 +494,27 @@ func TestPullCreateComment(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullDeleteComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		MatchParam("version", "0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.DeleteComment(context.Background(), "PRJ/my-repo", 1, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestReleaseFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.Find(context.Background(), "PRJ/my-repo", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.List(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.Create(context.Background(), "PRJ/my-repo", &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.UploadAsset(context.Background(), "PRJ/my-repo", 1, &scm.ReleaseAssetInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -84,6 +84,28 @@ type hookInput struct {
 	} `json:"configuration"`
 }
 
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+type key struct {
+	Key struct {
+		ID    int    `json:"id"`
+		Text  string `json:"text"`
+		Label string `json:"label"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type keyInput struct {
+	Key struct {
+		Text  string `json:"text"`
+		Label string `json:"label,omitempty"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
 type status struct {
 	State string `json:"state"`
 	Key   string `json:"key"`
@@ -92,6 +114,11 @@ type status struct {
 	Desc  string `json:"description"`
 }
 
+type statuses struct {
+	pagination
+	Values []*status `json:"values"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -114,6 +141,15 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+// FindKey returns a repository SSH access key.
+func (s *repositoryService) FindKey(ctx context.Context, repo string, id string) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
 // FindPerms returns the repository permissions.
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	// HACK: test if the user has read access to the repository.
@@ -189,9 +225,23 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm
 	return convertHookList(out), res, err
 }
 
+// ListKeys returns a list of repository SSH access keys.
+func (s *repositoryService) ListKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh?%s", namespace, name, encodeListOptions(opts))
+	out := new(keys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertKeyList(out), res, err
+}
+
 // ListStatus returns a list of commit statuses.
 func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("rest/build-status/1.0/commits/%s?%s", ref, encodeListOptions(opts))
+	out := new(statuses)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertStatusList(out), res, err
 }
 
 // CreateHook creates a new repository webhook.
@@ -212,6 +262,19 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateKey creates a new repository SSH access key.
+func (s *repositoryService) CreateKey(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh", namespace, name)
+	in := new(keyInput)
+	in.Key.Text = input.Key
+	in.Key.Label = input.Title
+	in.Permission = convertFromKeyPermission(input.ReadOnly)
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
 // CreateStatus creates a new commit status.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("rest/build-status/1.0/commits/%s", ref)
@@ -256,6 +319,13 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// DeleteKey deletes a repository SSH access key.
+func (s *repositoryService) DeleteKey(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -324,6 +394,30 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertKeyList(from *keys) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.Key.ID),
+		Title:    from.Key.Label,
+		Key:      from.Key.Text,
+		ReadOnly: from.Permission != "REPO_WRITE",
+	}
+}
+
+func convertFromKeyPermission(readOnly bool) string {
+	if readOnly {
+		return "REPO_READ"
+	}
+	return "REPO_WRITE"
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push || from.Branch || from.Tag {
@@ -345,6 +439,24 @@ func convertFromHookEvents(from scm.HookEvents) []string {
 	return events
 }
 
+func convertStatusList(from *statuses) []*scm.Status {
+	to := []*scm.Status{}
+	for _, v := range from.Values {
+		to = append(to, convertStatus(v))
+	}
+	return to
+}
+
+func convertStatus(from *status) *scm.Status {
+	return &scm.Status{
+		State:  convertState(from.State),
+		Label:  from.Key,
+		Title:  from.Name,
+		Desc:   from.Desc,
+		Target: from.URL,
+	}
+}
+
 func convertFromState(from scm.State) string {
 	switch from {
 	case scm.StatePending, scm.StateRunning:
@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -245,11 +246,81 @@ func TestRepositoryList(t *testing.T) {
 	}
 }
 
+func TestRepositoryListAll(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/repos").
+		MatchParam("limit", "25").
+		MatchParam("start", "25").
+		MatchParam("permission", "REPO_READ").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos-2.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/repos").
+		MatchParam("limit", "25").
+		MatchParam("permission", "REPO_READ").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("http://example.com:7990")
+	pager := &scm.Pager{Size: 25}
+	got := []*scm.Repository{}
+	err := pager.All(context.Background(), &got, func(ctx context.Context, opts scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.List(ctx, opts)
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all pages requested")
+	}
+}
+
 func TestStatusList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		MatchParam("limit", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
 	client, _ := New("http://example.com:7990")
-	_, _, err := client.Repositories.ListStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", scm.ListOptions{Size: 30, Page: 1})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, res, err := client.Repositories.ListStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", scm.ListOptions{Size: 2, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Page.First, 1; got != want {
+		t.Errorf("Want Page.First %d, got %d", want, got)
+	}
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want Page.Next %d, got %d", want, got)
+	}
+
+	want := []*scm.Status{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -486,3 +557,120 @@ func TestConvertState(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.FindKey(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListKeys(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyList_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		ReplyError(errors.New("connection refused"))
+
+	client, _ := New("http://example.com:7990")
+	_, res, err := client.Repositories.ListKeys(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err == nil {
+		t.Errorf("Expect transport error")
+	}
+	if res != nil {
+		t.Errorf("Expect nil response")
+	}
+}
+
+func TestRepositoryKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		File("testdata/key_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	in := &scm.DeployKeyInput{
+		Title:    "deploy@example.com",
+		Key:      "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC3Xr0fRk1ZHq2R7Ko6B4n5AOqVgBvZrm2pGdsRj3Pc5yVbT9gTHc deploy@example.com",
+		ReadOnly: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.CreateKey(context.Background(), "PRJ/my-repo", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.DeleteKey(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,6 +6,7 @@ package stash
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +16,295 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertPullRequestReview(out, out.Anchor), res, err
 }
 
+// List returns the anchored comments added in the pull
+// request activities, so a page may contain fewer review
+// comments than the page size.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertActivityReviews(out), res, err
 }
 
+// Create adds a comment anchored to the line of the pull
+// request diff. Bitbucket Server needs to know whether the
+// line was added, removed or unchanged, so the file diff
+// is fetched first.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/diff/%s?contextLines=0", namespace, name, number, input.Path)
+	diff := new(diffs)
+	res, err := s.client.do(ctx, "GET", path, nil, diff)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &pullRequestCommentInput{
+		Text:   input.Body,
+		Anchor: convertFromReviewInput(input, diff),
+	}
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	out := new(pullRequestComment)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertPullRequestReview(out, out.Anchor), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	pulls := &pullService{s.client}
+	return pulls.DeleteComment(ctx, repo, number, id)
+}
+
+// ListSummaries returns the pull request reviewers that
+// approved or marked the pull request as needs work. The
+// reviewers are not paginated, so the list options are
+// ignored.
+func (s *reviewService) ListSummaries(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSummary, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(prReviewers)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertParticipantList(out), res, err
+}
+
+// Submit sets the participant status of the authenticated
+// user, which approves or marks the pull request as needs
+// work. The review body is added as a pull request comment.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSummaryInput) (*scm.ReviewSummary, *scm.Response, error) {
+	var status string
+	switch input.State {
+	case scm.ReviewStateApproved:
+		status = "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		status = "NEEDS_WORK"
+	case scm.ReviewStateCommented:
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	if input.State == scm.ReviewStateCommented && input.Body == "" && len(input.Comments) == 0 {
+		return nil, nil, &scm.OptionError{Option: "Body"}
+	}
+	var res *scm.Response
+	var err error
+	for _, comment := range input.Comments {
+		if _, res, err = s.Create(ctx, repo, number, comment); err != nil {
+			return nil, res, err
+		}
+	}
+	if input.Body != "" {
+		pulls := &pullService{s.client}
+		_, res, err = pulls.CreateComment(ctx, repo, number, &scm.CommentInput{Body: input.Body})
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	out := &scm.ReviewSummary{
+		State: input.State,
+		Body:  input.Body,
+		Sha:   input.Sha,
+	}
+	if status == "" {
+		return out, res, nil
+	}
+	reviewer := new(participant)
+	res, err = s.setStatus(ctx, repo, number, status, reviewer)
+	if err != nil {
+		return nil, res, err
+	}
+	out.ID = reviewer.User.ID
+	out.Author = *convertUser(&reviewer.User)
+	return out, res, nil
+}
+
+// Dismiss resets the participant status of the authenticated
+// user. The review id and message are ignored.
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	return s.setStatus(ctx, repo, number, "UNAPPROVED", nil)
+}
+
+func (s *reviewService) FindApprovals(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// setStatus sets the participant status of the authenticated
+// user. The participant endpoint requires the user slug, so
+// the authenticated user is fetched first.
+func (s *reviewService) setStatus(ctx context.Context, repo string, number int, status string, out *participant) (*scm.Response, error) {
+	users := &userService{s.client}
+	user, res, err := users.Find(ctx)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, user.Login)
+	in := &participantInput{Status: status}
+	if out == nil {
+		return s.client.do(ctx, "PUT", path, in, nil)
+	}
+	return s.client.do(ctx, "PUT", path, in, out)
+}
+
+type commentAnchor struct {
+	FromHash string `json:"fromHash,omitempty"`
+	ToHash   string `json:"toHash,omitempty"`
+	Line     int    `json:"line"`
+	LineType string `json:"lineType"`
+	FileType string `json:"fileType"`
+	Path     string `json:"path"`
+	SrcPath  string `json:"srcPath,omitempty"`
+	DiffType string `json:"diffType,omitempty"`
+}
+
+type diffs struct {
+	Diffs []struct {
+		Source *struct {
+			ToString string `json:"toString"`
+		} `json:"source"`
+		Destination *struct {
+			ToString string `json:"toString"`
+		} `json:"destination"`
+		Hunks []struct {
+			Segments []struct {
+				Type  string `json:"type"`
+				Lines []struct {
+					Source      int `json:"source"`
+					Destination int `json:"destination"`
+				} `json:"lines"`
+			} `json:"segments"`
+		} `json:"hunks"`
+	} `json:"diffs"`
+}
+
+type prReviewers struct {
+	Reviewers []*participant `json:"reviewers"`
+}
+
+type participant struct {
+	User               user   `json:"user"`
+	Role               string `json:"role"`
+	Approved           bool   `json:"approved"`
+	Status             string `json:"status"`
+	LastReviewedCommit string `json:"lastReviewedCommit"`
+}
+
+type participantInput struct {
+	Status string `json:"status"`
+}
+
+type participantRoleInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Role string `json:"role"`
+}
+
+// helper function returns the reviewers that approved or
+// marked the pull request as needs work.
+func convertParticipantList(from *prReviewers) []*scm.ReviewSummary {
+	to := []*scm.ReviewSummary{}
+	for _, v := range from.Reviewers {
+		var state scm.ReviewState
+		switch v.Status {
+		case "APPROVED":
+			state = scm.ReviewStateApproved
+		case "NEEDS_WORK":
+			state = scm.ReviewStateChangesRequested
+		default:
+			continue
+		}
+		to = append(to, &scm.ReviewSummary{
+			ID:     v.User.ID,
+			State:  state,
+			Sha:    v.LastReviewedCommit,
+			Author: *convertUser(&v.User),
+		})
+	}
+	return to
+}
+
+// helper function returns the anchored comments added in
+// the pull request activities.
+func convertActivityReviews(from *activities) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from.Values {
+		if v.Action != "COMMENTED" || v.CommentAction != "ADDED" || v.Comment == nil {
+			continue
+		}
+		anchor := v.CommentAnchor
+		if anchor == nil {
+			anchor = v.Comment.Anchor
+		}
+		if anchor == nil {
+			continue
+		}
+		to = append(to, convertPullRequestReview(v.Comment, anchor))
+	}
+	return to
+}
+
+func convertPullRequestReview(from *pullRequestComment, anchor *commentAnchor) *scm.Review {
+	comment := convertPullRequestComment(from)
+	to := &scm.Review{
+		ID:      comment.ID,
+		Body:    comment.Body,
+		Author:  comment.Author,
+		Created: comment.Created,
+		Updated: comment.Updated,
+	}
+	if anchor != nil {
+		to.Path = anchor.Path
+		to.Sha = anchor.ToHash
+		to.Line = anchor.Line
+		if anchor.FileType == "FROM" {
+			to.Side = scm.ReviewSideOld
+		}
+	}
+	return to
+}
+
+// helper function returns the comment anchor for the review
+// input. The line type is looked up in the file diff, and
+// lines outside the diff hunks are unchanged.
+func convertFromReviewInput(from *scm.ReviewInput, diff *diffs) *commentAnchor {
+	to := &commentAnchor{
+		Line:     from.Line,
+		LineType: "CONTEXT",
+		FileType: "TO",
+		Path:     from.Path,
+		DiffType: "EFFECTIVE",
+	}
+	want := "ADDED"
+	if from.Side == scm.ReviewSideOld {
+		to.FileType = "FROM"
+		want = "REMOVED"
+	}
+	for _, d := range diff.Diffs {
+		if d.Destination != nil {
+			to.Path = d.Destination.ToString
+		}
+		if d.Source != nil && d.Source.ToString != to.Path {
+			to.SrcPath = d.Source.ToString
+		}
+		for _, hunk := range d.Hunks {
+			for _, segment := range hunk.Segments {
+				if segment.Type != want {
+					continue
+				}
+				for _, line := range segment.Lines {
+					if (want == "ADDED" && line.Destination == from.Line) ||
+						(want == "REMOVED" && line.Source == from.Line) {
+						to.LineType = want
+					}
+				}
+			}
+		}
+	}
+	return to
 }
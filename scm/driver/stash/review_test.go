@@ -6,35 +6,282 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Find(context.Background(), "PRJ/my-repo", 1, 3)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comments.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.List(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 25, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/diff/README.md").
+		MatchParam("contextLines", "0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_diff.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "Please use a constant here.",
+			"anchor": map[string]interface{}{
+				"line":     12,
+				"lineType": "ADDED",
+				"fileType": "TO",
+				"path":     "README.md",
+				"diffType": "EFFECTIVE",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	input := &scm.ReviewInput{
+		Body: "Please use a constant here.",
+		Path: "README.md",
+		Line: 12,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/3").
+		MatchParam("version", "0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.Delete(context.Background(), "PRJ/my-repo", 1, 3)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestReviewAnchor(t *testing.T) {
+	diff := new(diffs)
+	raw, _ := ioutil.ReadFile("testdata/pr_diff.json")
+	json.Unmarshal(raw, diff)
+
+	tests := []struct {
+		line     int
+		side     scm.ReviewSide
+		lineType string
+		fileType string
+	}{
+		{12, scm.ReviewSideNew, "ADDED", "TO"},
+		{8, scm.ReviewSideOld, "REMOVED", "FROM"},
+		{8, scm.ReviewSideNew, "CONTEXT", "TO"},
+		{12, scm.ReviewSideOld, "CONTEXT", "FROM"},
+		{20, scm.ReviewSideNew, "CONTEXT", "TO"},
+	}
+	for _, test := range tests {
+		input := &scm.ReviewInput{Path: "README.md", Line: test.line, Side: test.side}
+		got := convertFromReviewInput(input, diff)
+		if got.LineType != test.lineType || got.FileType != test.fileType {
+			t.Errorf("Want %s %s anchor for %s line %d, got %s %s", test.lineType, test.fileType, test.side, test.line, got.LineType, got.FileType)
+		}
+		if got.Line != test.line || got.Path != "README.md" || got.SrcPath != "" {
+			t.Errorf("Want anchor on README.md line %d, got %s line %d", test.line, got.Path, got.Line)
+		}
+	}
+}
+
+func TestReviewListSummaries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviewers.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ListSummaries(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("plugins/servlet/applinks/whoami").
+		Reply(200).
+		Type("text/plain").
+		BodyString("jcitizen")
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/users/jcitizen").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jcitizen").
+		JSON(map[string]string{"status": "APPROVED"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateApproved,
+		Sha:   "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Submit(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSummary{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit_Empty(t *testing.T) {
+	input := &scm.ReviewSummaryInput{
+		State: scm.ReviewStateCommented,
+	}
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reviews.Submit(context.Background(), "PRJ/my-repo", 1, input)
+	if err, ok := err.(*scm.OptionError); !ok || err.Option != "Body" {
+		t.Errorf("Want Body OptionError, got %v", err)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("plugins/servlet/applinks/whoami").
+		Reply(200).
+		Type("text/plain").
+		BodyString("jcitizen")
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/users/jcitizen").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jcitizen").
+		JSON(map[string]string{"status": "UNAPPROVED"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.Dismiss(context.Background(), "PRJ/my-repo", 1, 1, "")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
 	}
 }
@@ -35,10 +35,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverStash
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
+	client.Releases = &releaseService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
@@ -68,12 +72,20 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		switch body := in.(type) {
+		case *rawBody:
+			req.Header = map[string][]string{
+				"Content-Type": {body.contentType},
+			}
+			req.Body = body.data
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header = map[string][]string{
+				"Content-Type": {"application/json"},
+			}
+			req.Body = buf
 		}
-		req.Body = buf
 	}
 
 	// execute the http request
@@ -85,12 +97,10 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 
 	// if an error is encountered, unmarshal and return the
 	// error response.
-	if res.Status == 401 {
-		return res, scm.ErrNotAuthorized
-	} else if res.Status > 300 {
+	if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, convertError(res, err)
 	}
 
 	if out == nil {
@@ -109,6 +119,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// rawBody is written to the request body as-is, without
+// json encoding. It is used to upload multipart forms.
+type rawBody struct {
+	contentType string
+	data        io.Reader
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -122,6 +139,7 @@ type pagination struct {
 // Error represents a Stash error.
 type Error struct {
 	Errors []struct {
+		Context         string `json:"context"`
 		Message         string `json:"message"`
 		ExceptionName   string `json:"exceptionName"`
 		CurrentVersion  int    `json:"currentVersion"`
@@ -130,5 +148,33 @@ type Error struct {
 }
 
 func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return ""
+	}
 	return e.Errors[0].Message
 }
+
+// helper function converts the stash error to the
+// common error type. Errors with a context are field
+// validation errors.
+func convertError(res *scm.Response, from *Error) *scm.Error {
+	to := &scm.Error{
+		Status:  res.Status,
+		Driver:  scm.DriverStash,
+		ID:      res.Header.Get("X-Arequestid"),
+		Message: from.Error(),
+	}
+	if len(from.Errors) != 0 {
+		to.Code = from.Errors[0].ExceptionName
+	}
+	for _, e := range from.Errors {
+		if e.Context == "" {
+			continue
+		}
+		to.Fields = append(to.Fields, scm.FieldError{
+			Field:   e.Context,
+			Message: e.Message,
+		})
+	}
+	return to
+}
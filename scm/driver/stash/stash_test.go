@@ -5,7 +5,15 @@
 package stash
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestClient(t *testing.T) {
@@ -34,3 +42,32 @@ func TestClient_Error(t *testing.T) {
 		t.Errorf("Expect error when invalid URL")
 	}
 }
+
+func TestClient_ErrorResponse(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		Reply(409).
+		Type("application/json").
+		File("testdata/error_conflict.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.CreateBranch(context.Background(), "PRJ/my-repo", "feature", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err == nil {
+		t.Errorf("Expect error response")
+		return
+	}
+	if !errors.Is(err, scm.ErrConflict) {
+		t.Errorf("Expect error matches scm.ErrConflict")
+	}
+
+	want := new(scm.Error)
+	raw, _ := ioutil.ReadFile("testdata/error_conflict.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -21,7 +21,6 @@ import (
 // TODO(bradrydzewski) push hook does not include repository git+http link
 // TODO(bradrydzewski) push hook does not include repository git+ssh link
 // TODO(bradrydzewski) push hook does not include repository html link
-// TODO(bradrydzewski) missing pull request synchrnoized webhook. See https://jira.atlassian.com/browse/BSERV-10279
 // TODO(bradrydzewski) pr hook does not include repository git+http link
 // TODO(bradrydzewski) pr hook does not include repository git+ssh link
 // TODO(bradrydzewski) pr hook does not include repository html link
@@ -42,15 +41,20 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	switch req.Header.Get("X-Event-Key") {
 	case "repo:refs_changed":
 		hook, err = s.parsePushHook(data)
-	case "pr:opened", "pr:declined", "pr:merged":
+	case "pr:opened", "pr:declined", "pr:merged", "pr:from_ref_updated", "pr:modified":
 		hook, err = s.parsePullRequest(data)
+	case "pr:reviewer:updated", "pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work":
+		hook, err = s.parsePullRequest(data)
+	case "pr:comment:added", "pr:comment:edited", "pr:comment:deleted":
+		hook, err = s.parsePullRequestComment(data)
+	case "repo:comment:added", "repo:comment:edited", "repo:comment:deleted":
+		hook, err = s.parseCommitComment(data)
+	default:
+		return nil, scm.ErrUnknownEvent
 	}
 	if err != nil {
 		return nil, err
 	}
-	if hook == nil {
-		return nil, nil
-	}
 
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -104,8 +108,58 @@ func (s *webhookService) parsePullRequest(data []byte) (scm.Webhook, error) {
 		dst.Action = scm.ActionClose
 	case "pr:merged":
 		dst.Action = scm.ActionMerge
+	case "pr:from_ref_updated":
+		// the source branch was updated with new commits,
+		// or was force pushed.
+		dst.Action = scm.ActionSync
+	case "pr:reviewer:approved":
+		dst.Action = scm.ActionApprove
+	case "pr:reviewer:unapproved":
+		dst.Action = scm.ActionUnapprove
+	case "pr:modified", "pr:reviewer:updated", "pr:reviewer:needs_work":
+		dst.Action = scm.ActionUpdate
+	default:
+		return nil, scm.ErrUnknownEvent
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parsePullRequestComment(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestCommentHook(src)
+	switch src.EventKey {
+	case "pr:comment:added":
+		dst.Action = scm.ActionCreate
+	case "pr:comment:edited":
+		dst.Action = scm.ActionUpdate
+	case "pr:comment:deleted":
+		dst.Action = scm.ActionDelete
+	default:
+		return nil, scm.ErrUnknownEvent
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseCommitComment(data []byte) (scm.Webhook, error) {
+	src := new(commitCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertCommitCommentHook(src)
+	switch src.EventKey {
+	case "repo:comment:added":
+		dst.Action = scm.ActionCreate
+	case "repo:comment:edited":
+		dst.Action = scm.ActionUpdate
+	case "repo:comment:deleted":
+		dst.Action = scm.ActionDelete
 	default:
-		return nil, nil
+		return nil, scm.ErrUnknownEvent
 	}
 	return dst, nil
 }
@@ -129,6 +183,23 @@ type pullRequestHook struct {
 	PullRequest *pr    `json:"pullRequest"`
 }
 
+type pullRequestCommentHook struct {
+	EventKey    string              `json:"eventKey"`
+	Date        string              `json:"date"`
+	Actor       *user               `json:"actor"`
+	PullRequest *pr                 `json:"pullRequest"`
+	Comment     *pullRequestComment `json:"comment"`
+}
+
+type commitCommentHook struct {
+	EventKey   string              `json:"eventKey"`
+	Date       string              `json:"date"`
+	Actor      *user               `json:"actor"`
+	Repository *repository         `json:"repository"`
+	Commit     string              `json:"commit"`
+	Comment    *pullRequestComment `json:"comment"`
+}
+
 type change struct {
 	Ref struct {
 		ID        string `json:"id"`
@@ -239,3 +310,34 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 		Sender:      *sender,
 	}
 }
+
+func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequestCommentHook {
+	repo := convertRepository(&src.PullRequest.ToRef.Repository)
+	pr := convertPullRequest(src.PullRequest)
+	comment := convertPullRequestComment(src.Comment)
+	sender := convertUser(src.Actor)
+
+	return &scm.PullRequestCommentHook{
+		Action:      scm.ActionCreate,
+		Repo:        *repo,
+		PullRequest: *pr,
+		Comment:     *comment,
+		Sender:      *sender,
+	}
+}
+
+func convertCommitCommentHook(src *commitCommentHook) *scm.CommitCommentHook {
+	repo := convertRepository(src.Repository)
+	comment := convertPullRequestComment(src.Comment)
+	sender := convertUser(src.Actor)
+
+	return &scm.CommitCommentHook{
+		Action: scm.ActionCreate,
+		Repo:   *repo,
+		Commit: scm.Commit{
+			Sha: src.Commit,
+		},
+		Comment: *comment,
+		Sender:  *sender,
+	}
+}
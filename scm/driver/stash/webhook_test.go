@@ -108,6 +108,72 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_declined.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request source branch updated (synchronized)
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:from_ref_updated",
+			before: "testdata/webhooks/pr_from_ref_updated.json",
+			after:  "testdata/webhooks/pr_from_ref_updated.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request modified (title, description or target)
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:modified",
+			before: "testdata/webhooks/pr_modified.json",
+			after:  "testdata/webhooks/pr_modified.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request approved by reviewer
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:approved",
+			before: "testdata/webhooks/pr_reviewer_approved.json",
+			after:  "testdata/webhooks/pr_reviewer_approved.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request reviewer unapproved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:unapproved",
+			before: "testdata/webhooks/pr_reviewer_unapproved.json",
+			after:  "testdata/webhooks/pr_reviewer_unapproved.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+
+		//
+		// pull request comment events
+		//
+
+		// pull request comment added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:added",
+			before: "testdata/webhooks/pr_comment_added.json",
+			after:  "testdata/webhooks/pr_comment_added.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request comment edited
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:edited",
+			before: "testdata/webhooks/pr_comment_edited.json",
+			after:  "testdata/webhooks/pr_comment_edited.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+
+		//
+		// commit comment events
+		//
+
+		// commit comment added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:comment:added",
+			before: "testdata/webhooks/repo_comment_added.json",
+			after:  "testdata/webhooks/repo_comment_added.json.golden",
+			obj:    new(scm.CommitCommentHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -164,6 +230,18 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhook_ErrUnknownEvent(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:forked")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
 func TestWebhookInvalid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
@@ -0,0 +1,112 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type (
+	// Error represents an error response returned by the
+	// source code management system. It matches the
+	// package errors with errors.Is based on the status
+	// code, for example a 404 matches ErrNotFound.
+	Error struct {
+		// Status is the http status code.
+		Status int
+
+		// Driver identifies the driver that returned the
+		// error.
+		Driver Driver
+
+		// ID is the request id, if returned by the provider.
+		ID string
+
+		// Code is the provider error code, if any.
+		Code string
+
+		// Message is the provider error message.
+		Message string
+
+		// Fields lists the field validation errors.
+		Fields []FieldError
+	}
+
+	// OptionError indicates the driver cannot honor an
+	// input option. It matches ErrNotSupported with
+	// errors.Is.
+	OptionError struct {
+		// Option is the name of the unsupported option.
+		Option string
+	}
+
+	// DeleteBranchError indicates the pull request was
+	// merged, but the source branch could not be deleted.
+	DeleteBranchError struct {
+		// Branch is the name of the source branch.
+		Branch string
+
+		// Err is the error returned deleting the branch.
+		Err error
+	}
+
+	// FieldError represents a validation error for a
+	// single field of the request.
+	FieldError struct {
+		Field   string
+		Code    string
+		Message string
+	}
+)
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Status != 0 {
+		return http.StatusText(e.Status)
+	}
+	return "Unknown Error"
+}
+
+// Is returns true if the error status code matches the
+// target error. The 401 and 403 status codes match
+// ErrNotAuthorized, 404 matches ErrNotFound, 409 matches
+// ErrConflict and 422 matches ErrValidation.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotAuthorized:
+		return e.Status == 401 || e.Status == 403
+	case ErrNotFound:
+		return e.Status == 404
+	case ErrConflict:
+		return e.Status == 409
+	case ErrValidation:
+		return e.Status == 422
+	}
+	return false
+}
+
+// Error returns the error message.
+func (e *OptionError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrNotSupported, e.Option)
+}
+
+// Is returns true if the target error is ErrNotSupported.
+func (e *OptionError) Is(target error) bool {
+	return target == ErrNotSupported
+}
+
+// Error returns the error message.
+func (e *DeleteBranchError) Error() string {
+	return fmt.Sprintf("Pull request merged, but branch %s was not deleted: %s", e.Branch, e.Err)
+}
+
+// Unwrap returns the error returned deleting the branch.
+func (e *DeleteBranchError) Unwrap() error {
+	return e.Err
+}
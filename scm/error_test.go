@@ -0,0 +1,71 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{401, ErrNotAuthorized},
+		{403, ErrNotAuthorized},
+		{404, ErrNotFound},
+		{409, ErrConflict},
+		{422, ErrValidation},
+	}
+	for _, test := range tests {
+		err := error(&Error{Status: test.status})
+		if !errors.Is(err, test.target) {
+			t.Errorf("Want status %d to match %q", test.status, test.target)
+		}
+		if errors.Is(err, ErrNotSupported) {
+			t.Errorf("Want status %d to not match %q", test.status, ErrNotSupported)
+		}
+	}
+	if errors.Is(&Error{Status: 500}, ErrNotFound) {
+		t.Errorf("Want status 500 to not match %q", ErrNotFound)
+	}
+}
+
+func TestError_Message(t *testing.T) {
+	tests := []struct {
+		err  *Error
+		want string
+	}{
+		{&Error{Status: 404, Message: "Repository dev/null not found"}, "Repository dev/null not found"},
+		{&Error{Status: 404}, "Not Found"},
+		{&Error{}, "Unknown Error"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Want error message %q, got %q", test.want, got)
+		}
+	}
+}
+
+func TestOptionError(t *testing.T) {
+	err := error(&OptionError{Option: "Method"})
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Want option error to match %q", ErrNotSupported)
+	}
+	if got, want := err.Error(), "Not Supported: Method"; got != want {
+		t.Errorf("Want error message %q, got %q", want, got)
+	}
+}
+
+func TestDeleteBranchError(t *testing.T) {
+	err := error(&DeleteBranchError{Branch: "feature", Err: &Error{Status: 403}})
+	if !errors.Is(err, ErrNotAuthorized) {
+		t.Errorf("Want delete branch error to match %q", ErrNotAuthorized)
+	}
+	if got, want := err.Error(), "Pull request merged, but branch feature was not deleted: Forbidden"; got != want {
+		t.Errorf("Want error message %q, got %q", want, got)
+	}
+}
@@ -636,7 +636,13 @@ func ExamplePullRequest_merge() {
 		log.Fatal(err)
 	}
 
-	_, err = client.PullRequests.Merge(ctx, "octocat/Hello-World", 1)
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		Sha:                "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		DeleteSourceBranch: true,
+	}
+
+	_, err = client.PullRequests.Merge(ctx, "octocat/Hello-World", 1, input)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -49,6 +49,39 @@ type (
 		Avatar string
 	}
 
+	// BranchProtection represents the protection rules
+	// applied to a git branch.
+	BranchProtection struct {
+		Branch string
+
+		// RequireStatusChecks requires status checks to
+		// pass before changes can be merged. StatusChecks
+		// optionally names the checks that must pass.
+		RequireStatusChecks bool
+		StatusChecks        []string
+
+		// RequiredApprovals is the number of approving
+		// reviews required before changes can be merged.
+		RequiredApprovals int
+
+		// RestrictPush restricts who can push to the branch
+		// to repository administrators and the users and
+		// teams listed in PushUsers and PushTeams.
+		RestrictPush bool
+		PushUsers    []string
+		PushTeams    []string
+	}
+
+	// TagInput provides the input fields required for
+	// creating a git tag. An annotated tag is created when
+	// a message is provided, otherwise a lightweight tag
+	// is created.
+	TagInput struct {
+		Name    string
+		Sha     string
+		Message string
+	}
+
 	// GitService provides access to git resources.
 	GitService interface {
 		// FindBranch finds a git branch by name.
@@ -60,6 +93,10 @@ type (
 		// FindTag finds a git tag by name.
 		FindTag(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
+		// FindBranchProtection returns the protection rules
+		// of a git branch.
+		FindBranchProtection(ctx context.Context, repo, name string) (*BranchProtection, *Response, error)
+
 		// ListBranches returns a list of git branches.
 		ListBranches(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
@@ -77,5 +114,22 @@ type (
 		// of the target commit, it is up to the driver to
 		// return a 2-way or 3-way diff changeset.
 		CompareChanges(ctx context.Context, repo, source, target string, opts ListOptions) ([]*Change, *Response, error)
+
+		// CreateBranch creates a git branch by name from the
+		// given commit sha.
+		CreateBranch(ctx context.Context, repo, name, sha string) (*Response, error)
+
+		// CreateTag creates a git tag.
+		CreateTag(ctx context.Context, repo string, input *TagInput) (*Reference, *Response, error)
+
+		// UpdateBranchProtection updates the protection rules
+		// of a git branch.
+		UpdateBranchProtection(ctx context.Context, repo, name string, input *BranchProtection) (*BranchProtection, *Response, error)
+
+		// DeleteBranch deletes a git branch by name.
+		DeleteBranch(ctx context.Context, repo, name string) (*Response, error)
+
+		// DeleteTag deletes a git tag by name.
+		DeleteTag(ctx context.Context, repo, name string) (*Response, error)
 	}
 )
@@ -12,28 +12,40 @@ import (
 type (
 	// Issue represents an issue.
 	Issue struct {
-		Number  int
-		Title   string
-		Body    string
-		Link    string
-		Labels  []string
-		Closed  bool
-		Locked  bool
-		Author  User
-		Created time.Time
-		Updated time.Time
+		Number    int
+		Title     string
+		Body      string
+		Link      string
+		Labels    []Label
+		Closed    bool
+		Locked    bool
+		Author    User
+		Assignees []User
+		Milestone *Milestone
+		Created   time.Time
+		Updated   time.Time
 	}
 
 	// IssueInput provides the input fields required for
-	// creating or updating an issue.
+	// creating or updating an issue. Empty fields are not
+	// changed when updating an issue. Labels and Assignees
+	// replace the issue labels and assignees when not nil,
+	// and an empty list removes them. The milestone is the
+	// milestone id, and is not changed when nil. A zero
+	// milestone id removes the milestone.
 	IssueInput struct {
-		Title string
-		Body  string
+		Title     string
+		Body      string
+		State     IssueState
+		Labels    []string
+		Assignees []string
+		Milestone *int
 	}
 
 	// IssueListOptions provides options for querying a
 	// list of repository issues.
 	IssueListOptions struct {
+		URL    string
 		Page   int
 		Size   int
 		Open   bool
@@ -78,13 +90,34 @@ type (
 		// DeleteComment deletes an issue comment.
 		DeleteComment(context.Context, string, int, int) (*Response, error)
 
+		// Update updates an issue.
+		Update(context.Context, string, int, *IssueInput) (*Issue, *Response, error)
+
 		// Close closes an issue.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens a closed issue.
+		Reopen(context.Context, string, int) (*Response, error)
+
 		// Lock locks an issue discussion.
 		Lock(context.Context, string, int) (*Response, error)
 
 		// Unlock unlocks an issue discussion.
 		Unlock(context.Context, string, int) (*Response, error)
+
+		// AddLabel adds the label to the issue.
+		AddLabel(context.Context, string, int, string) (*Response, error)
+
+		// RemoveLabel removes the label from the issue.
+		RemoveLabel(context.Context, string, int, string) (*Response, error)
+
+		// SetLabels replaces the issue labels.
+		SetLabels(context.Context, string, int, []string) (*Response, error)
+
+		// AddAssignees assigns the users to the issue.
+		AddAssignees(context.Context, string, int, []string) (*Response, error)
+
+		// RemoveAssignees unassigns the users from the issue.
+		RemoveAssignees(context.Context, string, int, []string) (*Response, error)
 	}
 )
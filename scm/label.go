@@ -0,0 +1,44 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// Label represents a repository label. The color is a
+	// hexadecimal color code without the leading hash.
+	Label struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
+	// LabelInput provides the input fields required for
+	// creating or updating a label. Empty fields are not
+	// changed when updating a label.
+	LabelInput struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
+	// LabelService provides access to label resources.
+	LabelService interface {
+		// Find returns the repository label by name.
+		Find(ctx context.Context, repo, name string) (*Label, *Response, error)
+
+		// List returns the repository labels.
+		List(ctx context.Context, repo string, opts ListOptions) ([]*Label, *Response, error)
+
+		// Create creates a new repository label.
+		Create(ctx context.Context, repo string, input *LabelInput) (*Label, *Response, error)
+
+		// Update updates the repository label by name.
+		Update(ctx context.Context, repo, name string, input *LabelInput) (*Label, *Response, error)
+
+		// Delete deletes the repository label by name.
+		Delete(ctx context.Context, repo, name string) (*Response, error)
+	}
+)
@@ -0,0 +1,60 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Milestone represents a repository milestone. The id is
+	// the milestone number with GitHub, and the milestone id
+	// with other providers.
+	Milestone struct {
+		ID          int
+		Title       string
+		Description string
+		Link        string
+		Closed      bool
+		DueDate     time.Time
+	}
+
+	// MilestoneInput provides the input fields required for
+	// creating or updating a milestone. Empty fields are not
+	// changed when updating a milestone.
+	MilestoneInput struct {
+		Title       string
+		Description string
+		DueDate     time.Time
+	}
+
+	// MilestoneListOptions provides options for querying a
+	// list of repository milestones.
+	MilestoneListOptions struct {
+		Page   int
+		Size   int
+		Open   bool
+		Closed bool
+	}
+
+	// MilestoneService provides access to milestone resources.
+	MilestoneService interface {
+		// Find returns the repository milestone by id.
+		Find(ctx context.Context, repo string, id int) (*Milestone, *Response, error)
+
+		// List returns the repository milestones.
+		List(ctx context.Context, repo string, opts MilestoneListOptions) ([]*Milestone, *Response, error)
+
+		// Create creates a new repository milestone.
+		Create(ctx context.Context, repo string, input *MilestoneInput) (*Milestone, *Response, error)
+
+		// Update updates the repository milestone.
+		Update(ctx context.Context, repo string, id int, input *MilestoneInput) (*Milestone, *Response, error)
+
+		// Close closes the repository milestone.
+		Close(ctx context.Context, repo string, id int) (*Response, error)
+	}
+)
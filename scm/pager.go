@@ -0,0 +1,100 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// DefaultPageSize is the number of items requested per page
+// when the pager page size is not set. It is within the
+// maximum page size accepted by every provider.
+const DefaultPageSize = 50
+
+// errPagerSlice is returned when the pager output is not a
+// pointer to a slice, or does not match the page results.
+var errPagerSlice = errors.New("scm: pager requires a pointer to a slice matching the page results")
+
+type (
+	// PageFunc returns a single page of results for the
+	// provided list options. The returned value must be a
+	// slice, typically the result of a List method.
+	PageFunc func(ctx context.Context, opts ListOptions) (interface{}, *Response, error)
+
+	// Pager walks all pages of a paginated list.
+	Pager struct {
+		// Size is the number of items requested per page.
+		Size int
+
+		// Limit caps the total number of items. A zero
+		// value does not limit the number of items.
+		Limit int
+	}
+)
+
+// All calls fn for each page and appends the results to the
+// slice pointed to by out. It stops when the last page is
+// reached, the item limit is reached or the context is
+// canceled. The next page is taken from the response page
+// values, preferring Page.NextURL when provided.
+func (p *Pager) All(ctx context.Context, out interface{}, fn PageFunc) error {
+	dst := reflect.ValueOf(out)
+	if dst.Kind() != reflect.Ptr || dst.Elem().Kind() != reflect.Slice {
+		return errPagerSlice
+	}
+	dst = dst.Elem()
+
+	opts := ListOptions{Page: 1, Size: p.Size}
+	if opts.Size == 0 {
+		opts.Size = DefaultPageSize
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page, res, err := fn(ctx, opts)
+		if err != nil {
+			return err
+		}
+		src := reflect.ValueOf(page)
+		if !src.IsValid() || src.Type() != dst.Type() {
+			return errPagerSlice
+		}
+		n := src.Len()
+		if p.Limit > 0 && dst.Len()+n > p.Limit {
+			n = p.Limit - dst.Len()
+		}
+		dst.Set(reflect.AppendSlice(dst, src.Slice(0, n)))
+
+		if src.Len() == 0 || (p.Limit > 0 && dst.Len() >= p.Limit) {
+			return nil
+		}
+		next, ok := nextPage(opts, res)
+		if !ok {
+			return nil
+		}
+		opts = next
+	}
+}
+
+// helper function returns the list options for the page
+// following the response, and false if this is the last page.
+func nextPage(opts ListOptions, res *Response) (ListOptions, bool) {
+	if res == nil {
+		return opts, false
+	}
+	switch {
+	case res.Page.NextURL != "" && res.Page.NextURL != opts.URL:
+		opts.URL = res.Page.NextURL
+		opts.Page = res.Page.Next
+	case res.Page.Next > opts.Page:
+		opts.Page = res.Page.Next
+	default:
+		return opts, false
+	}
+	return opts, true
+}
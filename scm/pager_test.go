@@ -0,0 +1,129 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestPager(t *testing.T) {
+	pages := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5}}
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		res := new(Response)
+		if opts.Page < 3 {
+			res.Page.Next = opts.Page + 1
+		}
+		return pages[opts.Page], res, nil
+	}
+
+	got := []int{}
+	err := new(Pager).All(context.Background(), &got, fn)
+	if err != nil {
+		t.Error(err)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Want items %v, got %v", want, got)
+	}
+}
+
+func TestPager_NextURL(t *testing.T) {
+	pages := map[string][]int{"": {1, 2}, "https://example.com/?after=2": {3}}
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		if opts.Size != DefaultPageSize {
+			t.Errorf("Want default page size %d, got %d", DefaultPageSize, opts.Size)
+		}
+		res := new(Response)
+		if opts.URL == "" {
+			res.Page.NextURL = "https://example.com/?after=2"
+		}
+		return pages[opts.URL], res, nil
+	}
+
+	got := []int{}
+	err := new(Pager).All(context.Background(), &got, fn)
+	if err != nil {
+		t.Error(err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Want items %v, got %v", want, got)
+	}
+}
+
+func TestPager_Limit(t *testing.T) {
+	calls := 0
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		calls++
+		res := new(Response)
+		res.Page.Next = opts.Page + 1
+		return []int{opts.Page, opts.Page}, res, nil
+	}
+
+	got := []int{}
+	pager := &Pager{Limit: 3}
+	err := pager.All(context.Background(), &got, fn)
+	if err != nil {
+		t.Error(err)
+	}
+	if want := []int{1, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Want items %v, got %v", want, got)
+	}
+	if calls != 2 {
+		t.Errorf("Want 2 pages requested, got %d", calls)
+	}
+}
+
+func TestPager_NoProgress(t *testing.T) {
+	calls := 0
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		calls++
+		res := new(Response)
+		res.Page.Next = 1
+		return []int{1}, res, nil
+	}
+
+	got := []int{}
+	err := new(Pager).All(context.Background(), &got, fn)
+	if err != nil {
+		t.Error(err)
+	}
+	if calls != 1 {
+		t.Errorf("Want 1 page requested, got %d", calls)
+	}
+}
+
+func TestPager_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		cancel()
+		res := new(Response)
+		res.Page.Next = opts.Page + 1
+		return []int{opts.Page}, res, nil
+	}
+
+	got := []int{}
+	err := new(Pager).All(ctx, &got, fn)
+	if err != context.Canceled {
+		t.Errorf("Want context canceled error, got %v", err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Want items %v, got %v", want, got)
+	}
+}
+
+func TestPager_InvalidOutput(t *testing.T) {
+	fn := func(ctx context.Context, opts ListOptions) (interface{}, *Response, error) {
+		return []string{"a"}, nil, nil
+	}
+
+	got := []int{}
+	if err := new(Pager).All(context.Background(), got, fn); err != errPagerSlice {
+		t.Errorf("Want error when output is not a pointer")
+	}
+	if err := new(Pager).All(context.Background(), &got, fn); err != errPagerSlice {
+		t.Errorf("Want error when output does not match the page results")
+	}
+}
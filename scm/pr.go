@@ -12,24 +12,28 @@ import (
 type (
 	// PullRequest represents a repository pull request.
 	PullRequest struct {
-		Number  int
-		Title   string
-		Body    string
-		Sha     string
-		Ref     string
-		Source  string
-		Target  string
-		Fork    string
-		Link    string
-		Diff    string
-		Closed  bool
-		Merged  bool
-		Base    Reference
-		Head    Reference
-		Author  User
-		Created time.Time
-		Updated time.Time
-		Labels  []Label
+		Number    int
+		Title     string
+		Body      string
+		Sha       string
+		Ref       string
+		Source    string
+		Target    string
+		Fork      string
+		Link      string
+		Diff      string
+		Closed    bool
+		Merged    bool
+		Draft     bool
+		Base      Reference
+		Head      Reference
+		Author    User
+		Created   time.Time
+		Updated   time.Time
+		Labels    []Label
+		Reviewers []User
+		Assignees []User
+		Milestone *Milestone
 	}
 
 	// PullRequestInput provides the input fields required for creating a pull request.
@@ -38,11 +42,60 @@ type (
 		Body   string
 		Source string
 		Target string
+		Draft  bool
+	}
+
+	// PullRequestUpdateInput provides the input fields for
+	// updating a pull request. Empty fields are unchanged.
+	PullRequestUpdateInput struct {
+		Title  string
+		Body   string
+		Target string
+		State  PullRequestState
+	}
+
+	// MergeInput provides the options for merging a pull
+	// request. Drivers return an OptionError for options
+	// the provider cannot honor.
+	MergeInput struct {
+		// Method is the merge method. The zero value uses
+		// the repository default.
+		Method MergeMethod
+
+		// Title and Message are the merge commit title and
+		// message. Providers without a separate title use
+		// the title as the first line of the message.
+		Title   string
+		Message string
+
+		// Sha is the expected head commit. The merge fails
+		// if the pull request head has moved.
+		Sha string
+
+		// DeleteSourceBranch deletes the source branch after
+		// the pull request is merged. Drivers that delete the
+		// branch with a separate request skip branches in a
+		// fork, and return a DeleteBranchError if the merge
+		// succeeds but the branch cannot be deleted.
+		DeleteSourceBranch bool
+
+		// MergeWhenPipelineSucceeds merges the pull request
+		// once the pipeline succeeds, instead of immediately.
+		MergeWhenPipelineSucceeds bool
+	}
+
+	// ReviewerInput provides the users and teams to request
+	// or remove as pull request reviewers. Teams are only
+	// supported by some providers.
+	ReviewerInput struct {
+		Users []string
+		Teams []string
 	}
 
 	// PullRequestListOptions provides options for querying
 	// a list of repository merge requests.
 	PullRequestListOptions struct {
+		URL    string
 		Page   int
 		Size   int
 		Open   bool
@@ -57,11 +110,6 @@ type (
 		Deleted bool
 	}
 
-	Label struct {
-		Name  string
-		Color string
-	}
-
 	// PullRequestService provides access to pull request resources.
 	PullRequestService interface {
 		// Find returns the repository pull request by number.
@@ -79,19 +127,47 @@ type (
 		// ListComments returns the pull request comment list.
 		ListComments(context.Context, string, int, ListOptions) ([]*Comment, *Response, error)
 
-		// Merge merges the repository pull request.
-		Merge(context.Context, string, int) (*Response, error)
+		// Merge merges the repository pull request. The merge
+		// input is optional and may be nil.
+		Merge(context.Context, string, int, *MergeInput) (*Response, error)
 
 		// Close closes the repository pull request.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens the closed repository pull request.
+		Reopen(context.Context, string, int) (*Response, error)
+
 		// Create creates a new pull request.
 		Create(context.Context, string, *PullRequestInput) (*PullRequest, *Response, error)
 
+		// Update updates the pull request.
+		Update(context.Context, string, int, *PullRequestUpdateInput) (*PullRequest, *Response, error)
+
 		// CreateComment creates a new pull request comment.
 		CreateComment(context.Context, string, int, *CommentInput) (*Comment, *Response, error)
 
 		// DeleteComment deletes an pull request comment.
 		DeleteComment(context.Context, string, int, int) (*Response, error)
+
+		// AddLabel adds the label to the pull request.
+		AddLabel(context.Context, string, int, string) (*Response, error)
+
+		// RemoveLabel removes the label from the pull request.
+		RemoveLabel(context.Context, string, int, string) (*Response, error)
+
+		// SetLabels replaces the pull request labels.
+		SetLabels(context.Context, string, int, []string) (*Response, error)
+
+		// RequestReviewers requests the pull request reviewers.
+		RequestReviewers(context.Context, string, int, *ReviewerInput) (*Response, error)
+
+		// RemoveReviewers removes the requested pull request reviewers.
+		RemoveReviewers(context.Context, string, int, *ReviewerInput) (*Response, error)
+
+		// AddAssignees assigns the users to the pull request.
+		AddAssignees(context.Context, string, int, []string) (*Response, error)
+
+		// RemoveAssignees unassigns the users from the pull request.
+		RemoveAssignees(context.Context, string, int, []string) (*Response, error)
 	}
 )
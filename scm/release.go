@@ -0,0 +1,98 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type (
+	// Release represents a repository release.
+	Release struct {
+		ID          int
+		Title       string
+		Description string
+		Link        string
+		Tag         string
+		Commitish   string
+		Draft       bool
+		Prerelease  bool
+		Created     time.Time
+		Published   time.Time
+	}
+
+	// ReleaseInput provides the input fields required for
+	// creating or updating a release. Empty fields are not
+	// changed when the release is updated, and the draft and
+	// prerelease flags are not changed when nil.
+	ReleaseInput struct {
+		Title       string
+		Description string
+		Tag         string
+		Commitish   string
+		Draft       *bool
+		Prerelease  *bool
+	}
+
+	// ReleaseAsset represents a file attached to a release.
+	ReleaseAsset struct {
+		ID          int
+		Name        string
+		Link        string
+		Size        int64
+		ContentType string
+		Created     time.Time
+	}
+
+	// ReleaseAssetInput provides the input fields required
+	// for uploading a release asset.
+	ReleaseAssetInput struct {
+		Name        string
+		ContentType string
+		Data        io.Reader
+
+		// Tag is the tag name of the release. It is
+		// required by GitLab, which identifies releases by
+		// tag name instead of id.
+		Tag string
+	}
+
+	// ReleaseService provides access to release resources.
+	ReleaseService interface {
+		// Find returns the release by id. GitLab identifies
+		// releases by tag name and returns ErrNotSupported.
+		Find(ctx context.Context, repo string, id int) (*Release, *Response, error)
+
+		// FindByTag returns the release by tag name.
+		FindByTag(ctx context.Context, repo, tag string) (*Release, *Response, error)
+
+		// List returns a list of releases.
+		List(ctx context.Context, repo string, opts ListOptions) ([]*Release, *Response, error)
+
+		// Create creates a new release.
+		Create(ctx context.Context, repo string, input *ReleaseInput) (*Release, *Response, error)
+
+		// Update updates the release by id. GitLab identifies
+		// releases by tag name and returns ErrNotSupported.
+		Update(ctx context.Context, repo string, id int, input *ReleaseInput) (*Release, *Response, error)
+
+		// UpdateByTag updates the release by tag name.
+		UpdateByTag(ctx context.Context, repo, tag string, input *ReleaseInput) (*Release, *Response, error)
+
+		// Delete deletes the release by id. GitLab identifies
+		// releases by tag name and returns ErrNotSupported.
+		Delete(ctx context.Context, repo string, id int) (*Response, error)
+
+		// DeleteByTag deletes the release by tag name.
+		DeleteByTag(ctx context.Context, repo, tag string) (*Response, error)
+
+		// UploadAsset uploads a file and attaches it to the
+		// release. GitLab ignores the release id and attaches
+		// the file to the release named by the input Tag.
+		UploadAsset(ctx context.Context, repo string, id int, input *ReleaseAssetInput) (*ReleaseAsset, *Response, error)
+	}
+)
@@ -70,6 +70,23 @@ type (
 		Tag                bool
 	}
 
+	// DeployKey represents a repository deploy key.
+	DeployKey struct {
+		ID       string
+		Title    string
+		Key      string
+		ReadOnly bool
+		Created  time.Time
+	}
+
+	// DeployKeyInput provides the input fields required
+	// for creating a repository deploy key.
+	DeployKeyInput struct {
+		Title    string
+		Key      string
+		ReadOnly bool
+	}
+
 	// Status represents a commit status.
 	Status struct {
 		State  State
@@ -112,6 +129,9 @@ type (
 		// FindHook returns a repository hook.
 		FindHook(context.Context, string, string) (*Hook, *Response, error)
 
+		// FindKey returns a repository deploy key.
+		FindKey(context.Context, string, string) (*DeployKey, *Response, error)
+
 		// FindPerms returns repository permissions.
 		FindPerms(context.Context, string) (*Perm, *Response, error)
 
@@ -121,12 +141,18 @@ type (
 		// ListHooks returns a list or repository hooks.
 		ListHooks(context.Context, string, ListOptions) ([]*Hook, *Response, error)
 
+		// ListKeys returns a list of repository deploy keys.
+		ListKeys(context.Context, string, ListOptions) ([]*DeployKey, *Response, error)
+
 		// ListStatus returns a list of commit statuses.
 		ListStatus(context.Context, string, string, ListOptions) ([]*Status, *Response, error)
 
 		// CreateHook creates a new repository hook.
 		CreateHook(context.Context, string, *HookInput) (*Hook, *Response, error)
 
+		// CreateKey creates a new repository deploy key.
+		CreateKey(context.Context, string, *DeployKeyInput) (*DeployKey, *Response, error)
+
 		// CreateStatus creates a new commit status.
 		CreateStatus(context.Context, string, string, *StatusInput) (*Status, *Response, error)
 
@@ -135,10 +161,8 @@ type (
 
 		// DeleteHook deletes a repository hook.
 		DeleteHook(context.Context, string, string) (*Response, error)
+
+		// DeleteKey deletes a repository deploy key.
+		DeleteKey(context.Context, string, string) (*Response, error)
 	}
 )
-
-// TODO(bradrydzewski): Add endpoint to get a repository deploy key
-// TODO(bradrydzewski): Add endpoint to list repository deploy keys
-// TODO(bradrydzewski): Add endpoint to create a repository deploy key
-// TODO(bradrydzewski): Add endpoint to delete a repository deploy key
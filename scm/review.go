@@ -10,13 +10,17 @@ import (
 )
 
 type (
-	// Review represents a review comment.
+	// Review represents a review comment. The comment is
+	// anchored to a line of the file at the path, on the
+	// new or old side of the diff. The line number is the
+	// line in the file, not the position in the diff.
 	Review struct {
 		ID      int
 		Body    string
 		Path    string
 		Sha     string
 		Line    int
+		Side    ReviewSide
 		Link    string
 		Author  User
 		Created time.Time
@@ -24,12 +28,48 @@ type (
 	}
 
 	// ReviewInput provides the input fields required for
-	// creating a review comment.
+	// creating a review comment. The line is the line in the
+	// file at the path, on the side of the diff. New side
+	// lines are in the file at the head commit, and old side
+	// lines are in the file at the base commit.
 	ReviewInput struct {
 		Body string
 		Sha  string
 		Path string
 		Line int
+		Side ReviewSide
+	}
+
+	// ReviewSummary represents a pull request review, which
+	// approves, requests changes to, or comments on the pull
+	// request as a whole.
+	ReviewSummary struct {
+		ID      int
+		State   ReviewState
+		Body    string
+		Sha     string
+		Link    string
+		Author  User
+		Created time.Time
+	}
+
+	// ReviewSummaryInput provides the input fields required
+	// for submitting a pull request review. The inline
+	// comments are submitted with the review.
+	ReviewSummaryInput struct {
+		State    ReviewState
+		Body     string
+		Sha      string
+		Comments []*ReviewInput
+	}
+
+	// Approvals represents the approval status of a pull
+	// request.
+	Approvals struct {
+		Required  int
+		Left      int
+		Approved  bool
+		Approvers []User
 	}
 
 	// ReviewService provides access to review resources.
@@ -45,5 +85,25 @@ type (
 
 		// Delete deletes a review comment.
 		Delete(context.Context, string, int, int) (*Response, error)
+
+		// ListSummaries returns the pull request reviews.
+		ListSummaries(context.Context, string, int, ListOptions) ([]*ReviewSummary, *Response, error)
+
+		// Submit submits a pull request review. A commented
+		// review requires a body or comments. GitLab, Bitbucket
+		// Cloud and Bitbucket Server post the comments, body and
+		// approval as separate requests, so Submit is not atomic
+		// and comments posted before a failed request remain.
+		Submit(context.Context, string, int, *ReviewSummaryInput) (*ReviewSummary, *Response, error)
+
+		// Dismiss dismisses a pull request review with a message.
+		Dismiss(context.Context, string, int, int, string) (*Response, error)
+
+		// FindApprovals returns the pull request approval
+		// status, including the number of approvals required
+		// to merge. It is supported by GitLab. Other drivers
+		// return ErrNotSupported, and the required approvals
+		// are read from the branch protection instead.
+		FindApprovals(context.Context, string, int) (*Approvals, *Response, error)
 	}
 )
@@ -0,0 +1,188 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+// defaultCacheSize is the number of responses kept by the
+// default in-memory store.
+const defaultCacheSize = 1000
+
+// cacheHeader is set on responses served from the cache.
+const cacheHeader = "X-From-Cache"
+
+// headers that identify the caller or change the response
+// representation, and are therefore part of the cache key.
+var cacheKeyHeaders = []string{
+	"Accept",
+	"Authorization",
+	"Private-Token",
+}
+
+// Cache is an http.RoundTripper that makes HTTP requests,
+// wrapping a base RoundTripper and caching GET responses
+// with an ETag or Last-Modified header. Cached responses
+// are revalidated with a conditional request and served
+// from the cache when the server responds 304 Not Modified.
+// GitHub does not count 304 responses against the rate
+// limit.
+//
+// The cache key includes the authorization headers, so the
+// Cache should be the base of the authorization transport:
+//
+//	client.Client = &http.Client{
+//		Transport: &transport.BearerToken{
+//			Token: token,
+//			Base: &transport.Cache{
+//				Store: transport.NewMemoryStore(100),
+//			},
+//		},
+//	}
+type Cache struct {
+	Base http.RoundTripper
+
+	// Store persists the cached responses. Defaults to an
+	// in-memory store with 1000 entries.
+	Store CacheStore
+
+	once sync.Once
+}
+
+// RoundTrip sends the request, serving the response from
+// the cache if it is not modified.
+func (t *Cache) RoundTrip(r *http.Request) (*http.Response, error) {
+	key := cacheKey(r)
+
+	// requests that modify a resource invalidate the cached
+	// response for the same url.
+	if r.Method != "GET" {
+		res, err := t.base().RoundTrip(r)
+		if err == nil && r.Method != "HEAD" && res.StatusCode < 400 {
+			t.store().Delete(key)
+		}
+		return res, err
+	}
+
+	// the request is sent unmodified if the caller sets
+	// its own conditional headers.
+	conditional := r.Header.Get("If-None-Match") != "" ||
+		r.Header.Get("If-Modified-Since") != ""
+
+	var cached *http.Response
+	if !conditional {
+		cached = t.load(key, r)
+	}
+
+	req := r
+	if cached != nil {
+		req = cloneRequest(r)
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	res, err := t.base().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && res.StatusCode == http.StatusNotModified {
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+		// the not modified response includes the current
+		// rate limit and validator headers.
+		for k, v := range res.Header {
+			switch k {
+			case "Content-Length", "Content-Type", "Transfer-Encoding":
+				continue
+			}
+			cached.Header[k] = v
+		}
+		cached.Header.Set(cacheHeader, "1")
+		return cached, nil
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+	if cacheable(res) {
+		if dump, err := httputil.DumpResponse(res, true); err == nil {
+			t.store().Set(key, dump)
+		}
+	}
+	return res, nil
+}
+
+// load returns the cached response for the key, or nil if
+// the response is not cached.
+func (t *Cache) load(key string, r *http.Request) *http.Response {
+	data, ok := t.store().Get(key)
+	if !ok {
+		return nil
+	}
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), r)
+	if err != nil {
+		t.store().Delete(key)
+		return nil
+	}
+	return res
+}
+
+// store returns the cache store. If no store is configured,
+// a default in-memory store is created.
+func (t *Cache) store() CacheStore {
+	t.once.Do(func() {
+		if t.Store == nil {
+			t.Store = NewMemoryStore(defaultCacheSize)
+		}
+	})
+	return t.Store
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *Cache) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// helper function returns the cache key for the request,
+// which is the url and a hash of the headers that identify
+// the caller.
+func cacheKey(r *http.Request) string {
+	h := sha256.New()
+	for _, name := range cacheKeyHeaders {
+		io.WriteString(h, r.Header.Get(name))
+		io.WriteString(h, "\n")
+	}
+	return r.URL.String() + " " + hex.EncodeToString(h.Sum(nil))
+}
+
+// helper function returns true if the response can be
+// cached and revalidated.
+func cacheable(res *http.Response) bool {
+	if res.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(res.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return res.Header.Get("ETag") != "" || res.Header.Get("Last-Modified") != ""
+}
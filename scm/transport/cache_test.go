@@ -0,0 +1,234 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestCache_ETag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(200).
+		SetHeaders(map[string]string{
+			"ETag":                  `"644b5b0155e6404a9cc4bd9d8b1ae730"`,
+			"X-RateLimit-Remaining": "59",
+		}).
+		BodyString(`{"name":"hello-world"}`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		MatchHeader("If-None-Match", `"644b5b0155e6404a9cc4bd9d8b1ae730"`).
+		Reply(304).
+		SetHeader("X-RateLimit-Remaining", "58")
+
+	client := &http.Client{Transport: &Cache{}}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("https://api.github.com/repos/octocat/hello-world")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+
+		if got, want := res.StatusCode, 200; got != want {
+			t.Errorf("Want status %d, got %d", want, got)
+		}
+		if got, want := string(body), `{"name":"hello-world"}`; got != want {
+			t.Errorf("Want body %s, got %s", want, got)
+		}
+		if i == 0 {
+			continue
+		}
+		if got, want := res.Header.Get("X-From-Cache"), "1"; got != want {
+			t.Errorf("Expect response served from cache")
+		}
+		if got, want := res.Header.Get("X-RateLimit-Remaining"), "58"; got != want {
+			t.Errorf("Want rate limit headers from the 304 response, got %s", got)
+		}
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestCache_LastModified(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/178504").
+		Reply(200).
+		SetHeader("Last-Modified", "Tue, 05 Dec 2017 06:14:01 GMT").
+		BodyString(`{"id":178504}`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/178504").
+		MatchHeader("If-Modified-Since", "Tue, 05 Dec 2017 06:14:01 GMT").
+		Reply(304)
+
+	client := &http.Client{Transport: &Cache{}}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("https://gitlab.com/api/v4/projects/178504")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+
+		if got, want := string(body), `{"id":178504}`; got != want {
+			t.Errorf("Want body %s, got %s", want, got)
+		}
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestCache_Modified(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		Reply(200).
+		SetHeader("ETag", `"v1"`).
+		BodyString(`{"sha":"6dcb09b5"}`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		MatchHeader("If-None-Match", `"v1"`).
+		Reply(200).
+		SetHeader("ETag", `"v2"`).
+		BodyString(`{"sha":"7fd1a60b"}`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		MatchHeader("If-None-Match", `"v2"`).
+		Reply(304)
+
+	client := &http.Client{Transport: &Cache{}}
+
+	want := []string{`{"sha":"6dcb09b5"}`, `{"sha":"7fd1a60b"}`, `{"sha":"7fd1a60b"}`}
+	for i := range want {
+		res, err := client.Get("https://api.github.com/repos/octocat/hello-world/branches/master")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+
+		if got := string(body); got != want[i] {
+			t.Errorf("Want body %s, got %s", want[i], got)
+		}
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(200).
+		SetHeader("ETag", `"v1"`).
+		BodyString(`{"name":"hello-world"}`)
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world").
+		Reply(200).
+		BodyString(`{"name":"hello-world"}`)
+
+	store := NewMemoryStore(10)
+	client := &http.Client{Transport: &Cache{Store: store}}
+
+	res, err := client.Get("https://api.github.com/repos/octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+
+	if got, want := store.Len(), 1; got != want {
+		t.Errorf("Want %d cached responses, got %d", want, got)
+	}
+
+	req, _ := http.NewRequest("PATCH", "https://api.github.com/repos/octocat/hello-world", strings.NewReader(`{"private":true}`))
+	res, err = client.Do(req)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+
+	if got, want := store.Len(), 0; got != want {
+		t.Errorf("Want cached response invalidated, got %d cached responses", got)
+	}
+}
+
+func TestCache_NotCacheable(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		BodyString(`{"login":"octocat"}`)
+
+	gock.New("https://api.github.com").
+		Get("/user/repos").
+		Reply(200).
+		SetHeaders(map[string]string{
+			"ETag":          `"v1"`,
+			"Cache-Control": "private, no-store",
+		}).
+		BodyString(`[]`)
+
+	store := NewMemoryStore(10)
+	client := &http.Client{Transport: &Cache{Store: store}}
+
+	for _, path := range []string{"/user", "/user/repos"} {
+		res, err := client.Get("https://api.github.com" + path)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+
+	if got, want := store.Len(), 0; got != want {
+		t.Errorf("Want %d cached responses, got %d", want, got)
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	a, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	a.Header.Set("Authorization", "Bearer mF_9.B5f-4.1JqM")
+	b, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	b.Header.Set("Authorization", "Bearer 3a7d7b18")
+	c, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	c.Header.Set("Authorization", "Bearer mF_9.B5f-4.1JqM")
+
+	if cacheKey(a) == cacheKey(b) {
+		t.Errorf("Expect cache key to differ by authorization")
+	}
+	if cacheKey(a) != cacheKey(c) {
+		t.Errorf("Expect cache key to match for the same request")
+	}
+}
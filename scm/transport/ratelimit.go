@@ -0,0 +1,212 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// RateLimitPolicy defines how the RateLimit transport
+// handles requests once the rate limit is exhausted.
+type RateLimitPolicy int
+
+// RateLimitPolicy values.
+const (
+	// RateLimitWait blocks until the rate limit resets.
+	RateLimitWait RateLimitPolicy = iota
+
+	// RateLimitFail returns a RateLimitError without
+	// sending the request.
+	RateLimitFail
+)
+
+// RateLimitError is returned when the rate limit is
+// exhausted and the request is not sent.
+type RateLimitError struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, resets at %s", e.Reset.Format(time.RFC3339))
+}
+
+// RateLimit is an http.RoundTripper that makes HTTP
+// requests, wrapping a base RoundTripper and tracking the
+// rate limit headers returned by GitHub, GitLab and Gitea.
+// When the rate limit is exhausted, or the server responds
+// with Retry-After to a 403 or 429, requests are delayed or
+// rejected according to the policy.
+type RateLimit struct {
+	Base http.RoundTripper
+
+	// Policy defines whether requests wait for the rate
+	// limit to reset or fail fast.
+	Policy RateLimitPolicy
+
+	// MaxWait limits the time a request waits for the rate
+	// limit to reset. A RateLimitError is returned if the
+	// reset is further away. A zero value does not limit
+	// the wait time.
+	MaxWait time.Duration
+
+	mu    sync.Mutex
+	rate  scm.Rate
+	until time.Time // requests are blocked until this time
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// Rate returns a snapshot of the last recorded rate limit.
+func (t *RateLimit) Rate() scm.Rate {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rate
+}
+
+// RoundTrip delays or rejects the request if the rate limit
+// is exhausted, and records the rate limit of the response.
+func (t *RateLimit) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := t.wait(r.Context()); err != nil {
+		return nil, err
+	}
+	res, err := t.base().RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	t.update(res)
+
+	// secondary rate limits respond with 403 or 429 and a
+	// Retry-After header. The request is sent again once
+	// the delay has passed if the body can be replayed.
+	if res.StatusCode != 403 && res.StatusCode != 429 {
+		return res, nil
+	}
+	if res.Header.Get("Retry-After") == "" || !replayable(r) {
+		return res, nil
+	}
+	if err := t.wait(r.Context()); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	res.Body.Close()
+	r2, err := rewindRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	res, err = t.base().RoundTrip(r2)
+	if err != nil {
+		return nil, err
+	}
+	t.update(res)
+	return res, nil
+}
+
+// wait blocks until requests are permitted, or returns a
+// RateLimitError according to the policy.
+func (t *RateLimit) wait(ctx context.Context) error {
+	t.mu.Lock()
+	rate, until := t.rate, t.until
+	t.mu.Unlock()
+
+	delay := until.Sub(t.clock())
+	if delay <= 0 {
+		return nil
+	}
+	if t.Policy == RateLimitFail || (t.MaxWait > 0 && delay > t.MaxWait) {
+		return &RateLimitError{
+			Limit:     rate.Limit,
+			Remaining: rate.Remaining,
+			Reset:     until,
+		}
+	}
+	if t.sleep != nil {
+		return t.sleep(ctx, delay)
+	}
+	return sleepContext(ctx, delay)
+}
+
+// update records the rate limit headers of the response.
+func (t *RateLimit) update(res *http.Response) {
+	now := t.clock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if rate, ok := parseRate(res.Header, now); ok {
+		t.rate = rate
+		if rate.Remaining == 0 {
+			t.until = time.Unix(rate.Reset, 0)
+		}
+	}
+	if res.StatusCode == 403 || res.StatusCode == 429 {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After"), now); ok {
+			t.until = now.Add(d)
+		}
+	}
+}
+
+// clock returns the current time.
+func (t *RateLimit) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *RateLimit) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// helper function parses the rate limit headers. GitHub
+// and Gitea use the X-RateLimit prefix and GitLab uses the
+// RateLimit prefix. The reset value is a unix timestamp, or
+// the number of seconds until the reset.
+func parseRate(h http.Header, now time.Time) (scm.Rate, bool) {
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		remaining := h.Get(prefix + "Remaining")
+		if remaining == "" {
+			continue
+		}
+		rate := scm.Rate{}
+		rate.Remaining, _ = strconv.Atoi(remaining)
+		rate.Limit, _ = strconv.Atoi(h.Get(prefix + "Limit"))
+		rate.Reset, _ = strconv.ParseInt(h.Get(prefix+"Reset"), 10, 64)
+		if rate.Reset < now.Unix()/2 {
+			rate.Reset += now.Unix()
+		}
+		return rate, true
+	}
+	return scm.Rate{}, false
+}
+
+// helper function parses the Retry-After header, which is
+// either a number of seconds or an http date.
+func parseRetryAfter(s string, now time.Time) (time.Duration, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(s); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(s); err == nil {
+		return date.Sub(now), true
+	}
+	return 0, false
+}
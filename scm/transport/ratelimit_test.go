@@ -0,0 +1,247 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+var mockNow = time.Unix(1512454441, 0)
+
+func TestRateLimit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		SetHeaders(map[string]string{
+			"X-RateLimit-Limit":     "60",
+			"X-RateLimit-Remaining": "59",
+			"X-RateLimit-Reset":     "1512458041",
+		})
+
+	transport := &RateLimit{now: func() time.Time { return mockNow }}
+	client := &http.Client{Transport: transport}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	want := scm.Rate{Limit: 60, Remaining: 59, Reset: 1512458041}
+	if diff := cmp.Diff(transport.Rate(), want); diff != "" {
+		t.Errorf("Unexpected rate limit")
+		t.Log(diff)
+	}
+}
+
+func TestRateLimit_GitLab(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		Reply(200).
+		SetHeaders(map[string]string{
+			"RateLimit-Limit":     "600",
+			"RateLimit-Remaining": "599",
+			"RateLimit-Reset":     "1512454501",
+		})
+
+	transport := &RateLimit{now: func() time.Time { return mockNow }}
+	client := &http.Client{Transport: transport}
+
+	res, err := client.Get("https://gitlab.com/api/v4/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	want := scm.Rate{Limit: 600, Remaining: 599, Reset: 1512454501}
+	if diff := cmp.Diff(transport.Rate(), want); diff != "" {
+		t.Errorf("Unexpected rate limit")
+		t.Log(diff)
+	}
+}
+
+func TestRateLimit_Fail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		SetHeaders(map[string]string{
+			"X-RateLimit-Limit":     "60",
+			"X-RateLimit-Remaining": "0",
+			"X-RateLimit-Reset":     "1512454501",
+		})
+
+	transport := &RateLimit{
+		Policy: RateLimitFail,
+		now:    func() time.Time { return mockNow },
+	}
+	client := &http.Client{Transport: transport}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	_, err = transport.RoundTrip(req)
+	if err == nil {
+		t.Errorf("Expect rate limit error")
+		return
+	}
+	want := &RateLimitError{Limit: 60, Remaining: 0, Reset: time.Unix(1512454501, 0)}
+	if diff := cmp.Diff(err, want); diff != "" {
+		t.Errorf("Unexpected rate limit error")
+		t.Log(diff)
+	}
+}
+
+func TestRateLimit_Wait(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(2).
+		Reply(200).
+		SetHeaders(map[string]string{
+			"X-RateLimit-Limit":     "60",
+			"X-RateLimit-Remaining": "0",
+			"X-RateLimit-Reset":     "1512454501",
+		})
+
+	var waited time.Duration
+	transport := &RateLimit{
+		now: func() time.Time { return mockNow },
+		sleep: func(ctx context.Context, d time.Duration) error {
+			waited = d
+			return nil
+		},
+	}
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("https://api.github.com/user")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+
+	if got, want := waited, time.Minute; got != want {
+		t.Errorf("Want wait %s, got %s", want, got)
+	}
+}
+
+func TestRateLimit_MaxWait(t *testing.T) {
+	transport := &RateLimit{
+		MaxWait: time.Second,
+		until:   mockNow.Add(time.Minute),
+		now:     func() time.Time { return mockNow },
+	}
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	_, err := transport.RoundTrip(req)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Expect rate limit error when the wait exceeds the maximum")
+	}
+}
+
+func TestRateLimit_Canceled(t *testing.T) {
+	transport := &RateLimit{
+		until: time.Now().Add(time.Minute),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	_, err := transport.RoundTrip(req.WithContext(ctx))
+	if err != context.Canceled {
+		t.Errorf("Want context canceled error, got %v", err)
+	}
+}
+
+func TestRateLimit_RetryAfter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		BodyString("{}").
+		Reply(403).
+		SetHeader("Retry-After", "30")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		BodyString("{}").
+		Reply(201)
+
+	var waited time.Duration
+	transport := &RateLimit{
+		now: func() time.Time { return mockNow },
+		sleep: func(ctx context.Context, d time.Duration) error {
+			waited = d
+			return nil
+		},
+	}
+	client := &http.Client{Transport: transport}
+
+	res, err := client.Post("https://api.github.com/repos/octocat/hello-world/issues", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 201; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := waited, 30*time.Second; got != want {
+		t.Errorf("Want wait %s, got %s", want, got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		delay time.Duration
+		ok    bool
+	}{
+		{"120", 2 * time.Minute, true},
+		{mockNow.Add(time.Minute).UTC().Format(http.TimeFormat), time.Minute, true},
+		{"", 0, false},
+		{"soon", 0, false},
+	}
+	for _, test := range tests {
+		delay, ok := parseRetryAfter(test.value, mockNow)
+		if delay != test.delay || ok != test.ok {
+			t.Errorf("Want Retry-After %q parsed as %s, got %s", test.value, test.delay, delay)
+		}
+	}
+}
+
+func TestParseRate_RelativeReset(t *testing.T) {
+	h := http.Header{}
+	h.Set("RateLimit-Remaining", "0")
+	h.Set("RateLimit-Reset", "60")
+	rate, _ := parseRate(h, mockNow)
+	if got, want := rate.Reset, mockNow.Unix()+60; got != want {
+		t.Errorf("Want reset %d, got %d", want, got)
+	}
+}
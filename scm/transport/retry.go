@@ -0,0 +1,261 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// default retry settings.
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 250 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+// idempotent methods are retried by default.
+var idempotent = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+	"TRACE":   true,
+	"PUT":     true,
+	"DELETE":  true,
+}
+
+// Retry is an http.RoundTripper that makes HTTP requests,
+// wrapping a base RoundTripper and retrying requests that
+// fail with a network error or a 429, 500, 502, 503 or 504
+// status code. Retries use exponential backoff with jitter
+// and honor the Retry-After header.
+//
+// Retry composes with the authorization transports, for
+// example:
+//
+//	client.Client = &http.Client{
+//		Transport: &transport.Retry{
+//			Base: &transport.BearerToken{Token: token},
+//		},
+//	}
+type Retry struct {
+	Base http.RoundTripper
+
+	// MaxRetries is the maximum number of retries for a
+	// single request. Defaults to 3 if zero. A negative
+	// value disables retries.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff bound the delay between
+	// attempts. Default to 250ms and 10s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Methods lists the retried request methods. Defaults
+	// to the idempotent methods.
+	Methods []string
+
+	// Budget optionally limits the number of retries across
+	// all requests sharing the budget.
+	Budget *RetryBudget
+
+	sleep func(context.Context, time.Duration) error
+}
+
+// RoundTrip sends the request, retrying transient failures.
+func (t *Retry) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !t.retryable(r.Method) || t.maxRetries() == 0 {
+		return t.base().RoundTrip(r)
+	}
+	if t.Budget != nil {
+		t.Budget.deposit()
+	}
+	// buffer the request body if it cannot be recreated,
+	// so the request can be sent again.
+	if !replayable(r) {
+		buf, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r = cloneRequest(r)
+		r.Body = ioutil.NopCloser(bytes.NewReader(buf))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+	}
+
+	ctx := r.Context()
+	req := r
+	for attempt := 0; ; attempt++ {
+		res, err := t.base().RoundTrip(req)
+		if !retryableResponse(ctx, res, err) || attempt >= t.maxRetries() {
+			return res, err
+		}
+		delay := t.backoff(attempt)
+		if res != nil {
+			if d, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				delay = d
+			}
+		}
+		// give up if the delay exceeds the maximum backoff or
+		// the context deadline, or the retry budget is
+		// exhausted.
+		if delay > t.maxBackoff() {
+			return res, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return res, err
+		}
+		if t.Budget != nil && !t.Budget.withdraw() {
+			return res, err
+		}
+		if res != nil {
+			io.Copy(ioutil.Discard, io.LimitReader(res.Body, 4096))
+			res.Body.Close()
+		}
+		if err := t.wait(ctx, delay); err != nil {
+			return nil, err
+		}
+		if req, err = rewindRequest(r); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable returns true if requests with the given method
+// are retried.
+func (t *Retry) retryable(method string) bool {
+	if len(t.Methods) == 0 {
+		return idempotent[method]
+	}
+	for _, m := range t.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given attempt is
+// retried, chosen at random between half and all of the
+// exponential backoff.
+func (t *Retry) backoff(attempt int) time.Duration {
+	min, max := t.MinBackoff, t.maxBackoff()
+	if min == 0 {
+		min = defaultMinBackoff
+	}
+	d := min << uint(attempt)
+	if d > max || d <= 0 {
+		d = max
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (t *Retry) maxBackoff() time.Duration {
+	if t.MaxBackoff == 0 {
+		return defaultMaxBackoff
+	}
+	return t.MaxBackoff
+}
+
+func (t *Retry) maxRetries() int {
+	switch {
+	case t.MaxRetries == 0:
+		return defaultMaxRetries
+	case t.MaxRetries < 0:
+		return 0
+	default:
+		return t.MaxRetries
+	}
+}
+
+func (t *Retry) wait(ctx context.Context, d time.Duration) error {
+	if t.sleep != nil {
+		return t.sleep(ctx, d)
+	}
+	return sleepContext(ctx, d)
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *Retry) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// helper function returns true if the request failed with
+// a transient error and should be retried.
+func retryableResponse(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case 429, 500, 502, 503, 504:
+		return true
+	}
+	return false
+}
+
+// RetryBudget limits the number of retries across requests
+// so that retries do not amplify an outage. Every request
+// adds the ratio to the budget, up to the maximum, and
+// every retry withdraws one from the budget.
+type RetryBudget struct {
+	mu     sync.Mutex
+	ratio  float64
+	max    float64
+	tokens float64
+}
+
+// NewRetryBudget returns a retry budget that initially
+// permits max retries, and then permits retrying the ratio
+// of requests, for example 0.1 for one in ten requests.
+func NewRetryBudget(max int, ratio float64) *RetryBudget {
+	return &RetryBudget{
+		ratio:  ratio,
+		max:    float64(max),
+		tokens: float64(max),
+	}
+}
+
+// Remaining returns the number of retries currently
+// permitted by the budget.
+func (b *RetryBudget) Remaining() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return int(b.tokens)
+}
+
+func (b *RetryBudget) deposit() {
+	b.mu.Lock()
+	b.tokens += b.ratio
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+	b.mu.Unlock()
+}
+
+func (b *RetryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,334 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+)
+
+// helper function returns a sleep function that records
+// the delays without sleeping.
+func recordSleep(delays *[]time.Duration) func(context.Context, time.Duration) error {
+	return func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return nil
+	}
+}
+
+func TestRetry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(502)
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{sleep: recordSleep(&delays)},
+	}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := len(delays), 1; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetry_MaxRetries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(3).
+		Reply(503)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{MaxRetries: 2, sleep: recordSleep(&delays)},
+	}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 503; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := len(delays), 2; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all attempts sent")
+	}
+}
+
+func TestRetry_Disabled(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(2).
+		Reply(503)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{MaxRetries: -1, sleep: recordSleep(&delays)},
+	}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 503; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := len(delays), 0; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+	if !gock.IsPending() {
+		t.Errorf("Expect a single attempt sent")
+	}
+}
+
+func TestRetry_NotIdempotent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		Reply(502)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{sleep: recordSleep(&delays)},
+	}
+
+	res, err := client.Post("https://api.github.com/repos/octocat/hello-world/issues", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 502; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if len(delays) != 0 {
+		t.Errorf("Expect POST request not retried")
+	}
+}
+
+func TestRetry_ReplayBody(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/contents/README").
+		BodyString(`{"message":"update"}`).
+		Reply(502)
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/contents/README").
+		BodyString(`{"message":"update"}`).
+		Reply(200)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{sleep: recordSleep(&delays)},
+	}
+
+	// the multi reader hides the body type, so the request
+	// body cannot be recreated with GetBody.
+	body := io.MultiReader(strings.NewReader(`{"message":"update"}`))
+	req, _ := http.NewRequest("PUT", "https://api.github.com/repos/octocat/hello-world/contents/README", body)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+}
+
+func TestRetry_Budget(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(3).
+		Reply(502)
+
+	var delays []time.Duration
+	budget := NewRetryBudget(1, 0)
+	client := &http.Client{
+		Transport: &Retry{
+			MaxRetries: 1,
+			Budget:     budget,
+			sleep:      recordSleep(&delays),
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("https://api.github.com/user")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+
+	if got, want := len(delays), 1; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+	if got, want := budget.Remaining(), 0; got != want {
+		t.Errorf("Want %d retries remaining, got %d", want, got)
+	}
+}
+
+func TestRetry_Deadline(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(502)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{
+			MinBackoff: time.Minute,
+			sleep:      recordSleep(&delays),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 502; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if len(delays) != 0 {
+		t.Errorf("Expect no retry past the context deadline")
+	}
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(503).
+		SetHeader("Retry-After", "2")
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{sleep: recordSleep(&delays)},
+	}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if len(delays) != 1 || delays[0] != 2*time.Second {
+		t.Errorf("Expect retry delayed by the Retry-After header, got %v", delays)
+	}
+}
+
+func TestRetry_Authorization(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		MatchHeader("Authorization", "Bearer mF_9.B5f-4.1JqM").
+		Reply(502)
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		MatchHeader("Authorization", "Bearer mF_9.B5f-4.1JqM").
+		Reply(200)
+
+	var delays []time.Duration
+	client := &http.Client{
+		Transport: &Retry{
+			Base:  &BearerToken{Token: "mF_9.B5f-4.1JqM"},
+			sleep: recordSleep(&delays),
+		},
+	}
+
+	res, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+}
+
+func TestRetry_Backoff(t *testing.T) {
+	retry := &Retry{
+		MinBackoff: time.Second,
+		MaxBackoff: 4 * time.Second,
+	}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 500 * time.Millisecond, time.Second},
+		{1, time.Second, 2 * time.Second},
+		{2, 2 * time.Second, 4 * time.Second},
+		{5, 2 * time.Second, 4 * time.Second},
+		{100, 2 * time.Second, 4 * time.Second},
+	}
+	for _, test := range tests {
+		d := retry.backoff(test.attempt)
+		if d < test.min || d > test.max {
+			t.Errorf("Want attempt %d backoff between %s and %s, got %s", test.attempt, test.min, test.max, d)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheStore stores the responses cached by the Cache
+// transport. A store that fails to read or write an entry
+// should treat it as a cache miss.
+type CacheStore interface {
+	// Get returns the cached response for the key.
+	Get(key string) ([]byte, bool)
+
+	// Set caches the response for the key.
+	Set(key string, data []byte)
+
+	// Delete removes the cached response for the key.
+	Delete(key string)
+}
+
+// MemoryStore is an in-memory CacheStore that evicts the
+// least recently used entries once the store is full.
+type MemoryStore struct {
+	mu    sync.Mutex
+	size  int
+	list  *list.List
+	items map[string]*list.Element
+}
+
+// memoryEntry is a cached response in the MemoryStore.
+type memoryEntry struct {
+	key  string
+	data []byte
+}
+
+// NewMemoryStore returns an in-memory store that holds up
+// to size entries.
+func NewMemoryStore(size int) *MemoryStore {
+	return &MemoryStore{
+		size:  size,
+		list:  list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+// Get returns the cached response for the key.
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	s.list.MoveToFront(elem)
+	return elem.Value.(*memoryEntry).data, true
+}
+
+// Set caches the response for the key, evicting the least
+// recently used entry if the store is full.
+func (s *MemoryStore) Set(key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.items[key]; ok {
+		elem.Value.(*memoryEntry).data = data
+		s.list.MoveToFront(elem)
+		return
+	}
+	s.items[key] = s.list.PushFront(&memoryEntry{key, data})
+	for s.size > 0 && s.list.Len() > s.size {
+		elem := s.list.Back()
+		s.list.Remove(elem)
+		delete(s.items, elem.Value.(*memoryEntry).key)
+	}
+}
+
+// Delete removes the cached response for the key.
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.items[key]; ok {
+		s.list.Remove(elem)
+		delete(s.items, key)
+	}
+}
+
+// Len returns the number of cached responses.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Len()
+}
+
+// FileStore is a CacheStore that saves each cached
+// response to a file in a directory, so the cache is kept
+// across process restarts.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store that saves responses in
+// the directory, which is created if it does not exist.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{dir: dir}
+}
+
+// Get returns the cached response for the key.
+func (s *FileStore) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Set caches the response for the key. The file is written
+// to a temporary file and renamed, so concurrent readers
+// never see a partial response.
+func (s *FileStore) Set(key string, data []byte) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return
+	}
+	f, err := ioutil.TempFile(s.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete removes the cached response for the key.
+func (s *FileStore) Delete(key string) {
+	os.Remove(s.path(key))
+}
+
+// path returns the file path for the key.
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
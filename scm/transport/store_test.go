@@ -0,0 +1,69 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore(2)
+	store.Set("a", []byte("1"))
+	store.Set("b", []byte("2"))
+
+	// get moves the entry to the front, so b is the least
+	// recently used entry.
+	if data, ok := store.Get("a"); !ok || string(data) != "1" {
+		t.Errorf("Want cached entry a")
+	}
+	store.Set("c", []byte("3"))
+
+	if _, ok := store.Get("b"); ok {
+		t.Errorf("Expect least recently used entry evicted")
+	}
+	if _, ok := store.Get("a"); !ok {
+		t.Errorf("Want cached entry a")
+	}
+	if got, want := store.Len(), 2; got != want {
+		t.Errorf("Want %d entries, got %d", want, got)
+	}
+
+	store.Delete("a")
+	if _, ok := store.Get("a"); ok {
+		t.Errorf("Expect entry a deleted")
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-scm")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewFileStore(filepath.Join(dir, "cache"))
+	if _, ok := store.Get("a"); ok {
+		t.Errorf("Expect cache miss")
+	}
+
+	store.Set("a", []byte("1"))
+	if data, ok := store.Get("a"); !ok || string(data) != "1" {
+		t.Errorf("Want cached entry a")
+	}
+
+	// a new store for the same directory sees the entry.
+	if _, ok := NewFileStore(filepath.Join(dir, "cache")).Get("a"); !ok {
+		t.Errorf("Want cached entry a persisted")
+	}
+
+	store.Delete("a")
+	if _, ok := store.Get("a"); ok {
+		t.Errorf("Expect entry a deleted")
+	}
+}
@@ -4,7 +4,11 @@
 
 package transport
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 // cloneRequest returns a clone of the provided
 // http.Request. The clone is a shallow copy of the struct
@@ -20,3 +24,38 @@ func cloneRequest(r *http.Request) *http.Request {
 	}
 	return r2
 }
+
+// replayable returns true if the request body can be sent
+// again, either because the request has no body or the
+// body can be recreated with GetBody.
+func replayable(r *http.Request) bool {
+	return r.Body == nil || r.Body == http.NoBody || r.GetBody != nil
+}
+
+// rewindRequest returns a clone of the provided request
+// with a fresh copy of the request body, so the request
+// can be sent again.
+func rewindRequest(r *http.Request) (*http.Request, error) {
+	r2 := cloneRequest(r)
+	if r.GetBody != nil && r.Body != nil && r.Body != http.NoBody {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r2.Body = body
+	}
+	return r2, nil
+}
+
+// sleepContext pauses the current goroutine for the
+// duration d, or until the context is canceled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -73,6 +73,16 @@ type (
 		Sender  User
 	}
 
+	// CommitCommentHook represents a commit comment event,
+	// eg commit_comment.
+	CommitCommentHook struct {
+		Action  Action
+		Repo    Repository
+		Commit  Commit
+		Comment Comment
+		Sender  User
+	}
+
 	// PullRequestHook represents an pull request event,
 	// eg pull_request.
 	PullRequestHook struct {
@@ -99,6 +109,19 @@ type (
 		Repo        Repository
 		PullRequest PullRequest
 		Review      Review
+		Sender      User
+	}
+
+	// PipelineHook represents a pipeline event. This is
+	// currently a GitLab-specific event type.
+	PipelineHook struct {
+		Number int
+		Ref    Reference
+		State  State
+		Link   string
+		Repo   Repository
+		Commit Commit
+		Sender User
 	}
 
 	// DeployHook represents a deployment event. This is
@@ -137,6 +160,8 @@ func (h *DeployHook) Repository() Repository             { return h.Repo }
 func (h *TagHook) Repository() Repository                { return h.Repo }
 func (h *IssueHook) Repository() Repository              { return h.Repo }
 func (h *IssueCommentHook) Repository() Repository       { return h.Repo }
+func (h *CommitCommentHook) Repository() Repository      { return h.Repo }
 func (h *PullRequestHook) Repository() Repository        { return h.Repo }
 func (h *PullRequestCommentHook) Repository() Repository { return h.Repo }
 func (h *ReviewCommentHook) Repository() Repository      { return h.Repo }
+func (h *PipelineHook) Repository() Repository           { return h.Repo }